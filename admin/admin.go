@@ -0,0 +1,223 @@
+package admin
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"expvar"
+	"log/slog"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/debug"
+	profiles "runtime/pprof"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/x-ethr/server"
+	"github.com/x-ethr/server/health"
+	"github.com/x-ethr/server/internal/writer"
+	"github.com/x-ethr/server/logging"
+)
+
+// start represents the process's approximate start time, used for reporting uptime.
+var start = time.Now()
+
+// New constructs the administrative [server.Mux] using the optional [Variadic] settings. The following route(s) are
+// registered:
+//
+//   - "/debug/pprof/": [net/http/pprof] profile(s), e.g. "/debug/pprof/heap" or "/debug/pprof/profile?seconds=30".
+//   - "GET /debug/vars": [expvar] variable(s).
+//   - "GET /debug/goroutines": a plain-text dump of every goroutine's stack.
+//   - "/debug/log/level": the runtime log-level (see [logging.Levels]).
+//   - "GET /build": the binary's build information (see [debug.ReadBuildInfo]).
+//   - "GET /runtime": runtime statistics, e.g. goroutine count, heap usage and uptime.
+//   - "GET /healthz", "GET /readyz", "GET /startupz": the health probe(s); these are never subject to [Settings.Token].
+//
+// The pprof route(s) stream their response(s), bypassing the server's response buffering. CPU profile(s) and execution
+// trace(s) extend their write deadline by the requested duration ("seconds"), such that [server.Timeouts.Write] doesn't
+// cut them short.
+//
+//   - Binaries built with a Go toolchain prior to 1.23 reject profile(s) whose duration exceeds [server.Timeouts.Write]
+//     irrespective of the extended deadline; serve the admin Mux from its own server with a suitable write timeout.
+func New(options ...Variadic) *server.Mux {
+	var o = settings()
+	for _, option := range options {
+		option(o)
+	}
+
+	if o.Health == nil {
+		o.Health = health.Default
+	}
+
+	protected := func(options *server.Options) {
+		options.Middleware = append(options.Middleware, authorize(o.Token))
+	}
+
+	profiling := func(fallback time.Duration) server.Variadic {
+		return func(options *server.Options) {
+			options.Middleware = append(options.Middleware, authorize(o.Token), stream(fallback))
+		}
+	}
+
+	mux := server.New()
+
+	mux.Register("/debug/pprof/", pprof.Index, profiling(0))
+	mux.Register("/debug/pprof/cmdline", pprof.Cmdline, profiling(0))
+	mux.Register("/debug/pprof/profile", pprof.Profile, profiling(30*time.Second))
+	mux.Register("/debug/pprof/symbol", pprof.Symbol, profiling(0))
+	mux.Register("/debug/pprof/trace", pprof.Trace, profiling(time.Second))
+
+	mux.Handle("GET /debug/vars", expvar.Handler(), protected)
+	mux.Register("GET /debug/goroutines", goroutines, protected)
+	mux.Handle("/debug/log/level", logging.Levels(), protected)
+
+	mux.Register("GET /build", build, protected)
+	mux.Register("GET /runtime", statistics, protected)
+
+	mux.Handle("GET /healthz", o.Health.Handler(health.Liveness))
+	mux.Handle("GET /readyz", o.Health.Handler(health.Readiness))
+	mux.Handle("GET /startupz", o.Health.Handler(health.Startup))
+
+	return mux
+}
+
+// authorize returns a middleware requiring an "Authorization: Bearer <token>" header. An empty token disables authorization.
+func authorize(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if token == "" {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			value, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !(ok) || subtle.ConstantTimeCompare([]byte(value), []byte(token)) != 1 {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+
+				json.NewEncoder(w).Encode(map[string]interface{}{"code": http.StatusUnauthorized, "message": http.StatusText(http.StatusUnauthorized)})
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// stream returns a middleware bypassing the server's response buffering. If fallback is non-zero, the response's write
+// deadline is extended by the request's "seconds" query parameter (or fallback), plus a margin.
+func stream(fallback time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writer.Stream(w)
+
+			if fallback > 0 {
+				duration := fallback
+				if seconds, e := strconv.ParseFloat(r.FormValue("seconds"), 64); e == nil && seconds > 0 {
+					duration = time.Duration(seconds * float64(time.Second))
+				}
+
+				if e := http.NewResponseController(w).SetWriteDeadline(time.Now().Add(duration + 10*time.Second)); e != nil {
+					slog.WarnContext(r.Context(), "Unable to Extend Profile Write Deadline", slog.String("error", e.Error()))
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// goroutines writes a plain-text dump of every goroutine's stack.
+func goroutines(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+
+	profiles.Lookup("goroutine").WriteTo(w, 2)
+}
+
+// Build represents the binary's build information.
+type Build struct {
+	Go       string            `json:"go"`
+	Path     string            `json:"path"`
+	Module   string            `json:"module"`
+	Version  string            `json:"version"`
+	Revision string            `json:"revision,omitempty"`
+	Time     string            `json:"time,omitempty"`
+	Modified bool              `json:"modified"`
+	Settings map[string]string `json:"settings"`
+}
+
+func build(w http.ResponseWriter, r *http.Request) {
+	information, ok := debug.ReadBuildInfo()
+	if !(ok) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"code": http.StatusNotFound, "message": "build information unavailable"})
+
+		return
+	}
+
+	payload := Build{
+		Go:       information.GoVersion,
+		Path:     information.Path,
+		Module:   information.Main.Path,
+		Version:  information.Main.Version,
+		Settings: make(map[string]string, len(information.Settings)),
+	}
+
+	for _, setting := range information.Settings {
+		payload.Settings[setting.Key] = setting.Value
+
+		switch setting.Key {
+		case "vcs.revision":
+			payload.Revision = setting.Value
+		case "vcs.time":
+			payload.Time = setting.Value
+		case "vcs.modified":
+			payload.Modified = setting.Value == "true"
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	json.NewEncoder(w).Encode(payload)
+}
+
+// Statistics represents a snapshot of the process's runtime statistics.
+type Statistics struct {
+	Goroutines int    `json:"goroutines"`
+	CPUs       int    `json:"cpus"`
+	GOMAXPROCS int    `json:"gomaxprocs"`
+	Heap       uint64 `json:"heap"`   // Heap represents the allocated heap, in bytes.
+	System     uint64 `json:"system"` // System represents the memory obtained from the operating system, in bytes.
+	GC         uint32 `json:"gc"`     // GC represents the number of completed garbage collection cycle(s).
+	Pause      string `json:"pause"`  // Pause represents the cumulative garbage collection pause duration.
+	Uptime     string `json:"uptime"`
+}
+
+func statistics(w http.ResponseWriter, r *http.Request) {
+	var memory runtime.MemStats
+
+	runtime.ReadMemStats(&memory)
+
+	payload := Statistics{
+		Goroutines: runtime.NumGoroutine(),
+		CPUs:       runtime.NumCPU(),
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		Heap:       memory.HeapAlloc,
+		System:     memory.Sys,
+		GC:         memory.NumGC,
+		Pause:      time.Duration(memory.PauseTotalNs).String(),
+		Uptime:     time.Since(start).Round(time.Second).String(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	json.NewEncoder(w).Encode(payload)
+}
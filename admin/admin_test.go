@@ -0,0 +1,104 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/x-ethr/server"
+	"github.com/x-ethr/server/admin"
+	"github.com/x-ethr/server/health"
+)
+
+func TestAdmin(t *testing.T) {
+	handler := admin.New(func(options *admin.Settings) {
+		options.Token = "secret"
+		options.Health = health.New()
+	})
+
+	request := func(path, token string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		handler.ServeHTTP(recorder, r)
+
+		return recorder
+	}
+
+	t.Run("Authorization", func(t *testing.T) {
+		for _, path := range []string{"/debug/pprof/", "/debug/vars", "/debug/goroutines", "/debug/log/level", "/build", "/runtime"} {
+			if code := request(path, "").Code; code != http.StatusUnauthorized {
+				t.Errorf("Expected Unauthorized (%s), Received: %d", path, code)
+			}
+
+			if code := request(path, "invalid").Code; code != http.StatusUnauthorized {
+				t.Errorf("Expected Unauthorized (%s), Received: %d", path, code)
+			}
+
+			if code := request(path, "secret").Code; code != http.StatusOK {
+				t.Errorf("Expected OK (%s), Received: %d", path, code)
+			}
+		}
+	})
+
+	t.Run("Health", func(t *testing.T) {
+		for _, path := range []string{"/healthz", "/readyz", "/startupz"} {
+			if code := request(path, "").Code; code != http.StatusOK {
+				t.Errorf("Expected Unprotected Probe (%s), Received: %d", path, code)
+			}
+		}
+	})
+
+	t.Run("Runtime", func(t *testing.T) {
+		var statistics admin.Statistics
+		if e := json.NewDecoder(request("/runtime", "secret").Body).Decode(&statistics); e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if statistics.Goroutines == 0 || statistics.Heap == 0 {
+			t.Errorf("Unexpected Runtime Statistics: %+v", statistics)
+		}
+	})
+}
+
+func TestAdminProfile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Profiling Skipped in Short Mode")
+	}
+
+	ctx := context.Background()
+
+	instance := server.Server(ctx, admin.New(), "0", func(s *server.Settings) {
+		s.Host = "127.0.0.1"
+		s.Timeouts.Write = 500 * time.Millisecond
+	})
+
+	go instance.ListenAndServe()
+	defer instance.Close()
+
+	<-instance.Listening()
+
+	response, e := http.Get("http://" + instance.Addr().String() + "/debug/pprof/profile?seconds=1")
+	if e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	defer response.Body.Close()
+
+	body, e := io.ReadAll(response.Body)
+	if e != nil {
+		t.Fatalf("Expected Complete Profile Beyond the Write Timeout: %v", e)
+	}
+
+	if response.StatusCode != http.StatusOK || len(body) == 0 {
+		t.Errorf("Unexpected Profile Response: %d (%d bytes)", response.StatusCode, len(body))
+	}
+}
@@ -0,0 +1,9 @@
+// Package admin provides an administrative [net/http.Handler] bundling a common debugging surface: pprof profile(s),
+// expvar variable(s), goroutine dump(s), the runtime log-level endpoint, build information, runtime statistics, and the
+// health probe(s).
+//
+//   - The handler is intended for an internal-only server (see [github.com/x-ethr/server.Supervisor]), and optionally
+//     requires a bearer token (see [Settings.Token]).
+//   - Note that importing [net/http/pprof] and [expvar] registers their handler(s) on [net/http.DefaultServeMux]; avoid
+//     serving the default mux publicly.
+package admin
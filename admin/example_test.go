@@ -0,0 +1,23 @@
+package admin_test
+
+import (
+	"context"
+	"os"
+
+	"github.com/x-ethr/server"
+	"github.com/x-ethr/server/admin"
+)
+
+func Example() {
+	ctx := context.Background()
+
+	handler := admin.New(func(options *admin.Settings) {
+		options.Token = os.Getenv("ADMIN_TOKEN")
+	})
+
+	api := server.Server(ctx, handler, "9090", func(s *server.Settings) {
+		s.Host = "127.0.0.1"
+	})
+
+	api.ListenAndServe()
+}
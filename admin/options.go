@@ -0,0 +1,23 @@
+package admin
+
+import (
+	"github.com/x-ethr/server/health"
+	"github.com/x-ethr/server/internal/keystore"
+)
+
+type Settings struct {
+	// Token represents an optional bearer token required by every endpoint, except the health probe(s). Defaults to an
+	// empty string (no authorization).
+	Token string `json:"-" yaml:"-"`
+
+	// Health represents the [health.Registry] evaluated by the health probe(s). Defaults to [health.Default].
+	Health *health.Registry `json:"-" yaml:"-"`
+}
+
+type Variadic keystore.Variadic[Settings]
+
+func settings() *Settings {
+	return &Settings{
+		Health: health.Default,
+	}
+}
@@ -0,0 +1,6 @@
+// Package certificate provides a TLS certificate Watcher that reloads rotated certificate and key files without requiring a
+// server restart - e.g. a Kubernetes secret volume managed by cert-manager.
+//
+//   - The Watcher.GetCertificate function is intended for use as a [crypto/tls.Config] GetCertificate callback.
+//   - Reload events are logged, and counted via the "server.tls.certificate.reloads" metric.
+package certificate
@@ -0,0 +1,20 @@
+package certificate
+
+import (
+	"time"
+
+	"github.com/x-ethr/server/internal/keystore"
+)
+
+type Settings struct {
+	// Interval represents the polling interval used to detect rotated certificate and key file(s). Defaults to 30 seconds.
+	Interval time.Duration `json:"interval" yaml:"interval"`
+}
+
+type Variadic keystore.Variadic[Settings]
+
+func settings() *Settings {
+	return &Settings{
+		Interval: 30 * time.Second,
+	}
+}
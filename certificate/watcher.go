@@ -0,0 +1,157 @@
+package certificate
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Unavailable represents an error returned by [Watcher.GetCertificate] when a certificate has yet to be successfully loaded.
+var Unavailable = errors.New("tls certificate unavailable")
+
+// Watcher loads a TLS certificate and key pair, and reloads the pair upon change(s) to either file.
+type Watcher struct {
+	certificate, key string
+
+	options *Settings
+
+	pointer atomic.Pointer[tls.Certificate]
+
+	mutex    sync.Mutex
+	contents [2][]byte // contents represents the most recently loaded certificate and key file(s).
+
+	counter metric.Int64Counter
+}
+
+// New constructs a [Watcher] for the given certificate and key file path(s), and starts polling for change(s) until ctx
+// is cancelled.
+//
+//   - An error is returned if the initial load fails; the returned [Watcher] remains valid and will continue polling, such
+//     that a certificate provisioned after startup is eventually served.
+func New(ctx context.Context, certificate, key string, options ...Variadic) (*Watcher, error) {
+	var o = settings()
+	for _, option := range options {
+		option(o)
+	}
+
+	if o.Interval <= 0 {
+		o.Interval = 30 * time.Second
+	}
+
+	counter, e := otel.Meter("github.com/x-ethr/server/certificate").Int64Counter("server.tls.certificate.reloads", metric.WithDescription("The number of TLS certificate reload attempts, by outcome."))
+	if e != nil {
+		slog.WarnContext(ctx, "Unable to Instantiate TLS Certificate Reload Counter", slog.String("error", e.Error()))
+	}
+
+	w := &Watcher{certificate: certificate, key: key, options: o, counter: counter}
+
+	_, e = w.load(ctx)
+
+	go w.watch(ctx)
+
+	return w, e
+}
+
+// GetCertificate returns the most recently loaded certificate. See [tls.Config.GetCertificate].
+func (w *Watcher) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if certificate := w.pointer.Load(); certificate != nil {
+		return certificate, nil
+	}
+
+	return nil, Unavailable
+}
+
+// Reload forces the certificate and key file(s) to be re-read. The currently loaded certificate is only replaced if the
+// file(s) have changed, and are valid.
+func (w *Watcher) Reload(ctx context.Context) error {
+	_, e := w.load(ctx)
+
+	return e
+}
+
+func (w *Watcher) watch(ctx context.Context) {
+	ticker := time.NewTicker(w.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.load(ctx)
+		}
+	}
+}
+
+// load reads the certificate and key file(s), and if changed, parses and stores the pair. The returned boolean reports
+// whether a new certificate was stored.
+func (w *Watcher) load(ctx context.Context) (bool, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	certificate, e := os.ReadFile(w.certificate)
+	if e != nil {
+		return false, w.failure(ctx, fmt.Errorf("unable to read tls certificate file: %w", e))
+	}
+
+	key, e := os.ReadFile(w.key)
+	if e != nil {
+		return false, w.failure(ctx, fmt.Errorf("unable to read tls key file: %w", e))
+	}
+
+	if bytes.Equal(certificate, w.contents[0]) && bytes.Equal(key, w.contents[1]) {
+		return false, nil
+	}
+
+	pair, e := tls.X509KeyPair(certificate, key)
+	if e != nil {
+		return false, w.failure(ctx, fmt.Errorf("unable to parse tls key-pair: %w", e))
+	}
+
+	if pair.Leaf == nil {
+		if pair.Leaf, e = x509.ParseCertificate(pair.Certificate[0]); e != nil {
+			return false, w.failure(ctx, fmt.Errorf("unable to parse tls leaf certificate: %w", e))
+		}
+	}
+
+	initial := w.pointer.Load() == nil
+
+	w.contents = [2][]byte{certificate, key}
+	w.pointer.Store(&pair)
+
+	if initial {
+		slog.InfoContext(ctx, "Loaded TLS Certificate", slog.String("certificate", w.certificate), slog.Time("expiration", pair.Leaf.NotAfter))
+	} else {
+		slog.InfoContext(ctx, "Reloaded TLS Certificate", slog.String("certificate", w.certificate), slog.Time("expiration", pair.Leaf.NotAfter))
+	}
+
+	w.count(ctx, "success")
+
+	return true, nil
+}
+
+func (w *Watcher) failure(ctx context.Context, e error) error {
+	slog.ErrorContext(ctx, "Unable to Load TLS Certificate", slog.String("certificate", w.certificate), slog.String("key", w.key), slog.String("error", e.Error()))
+
+	w.count(ctx, "failure")
+
+	return e
+}
+
+func (w *Watcher) count(ctx context.Context, outcome string) {
+	if w.counter != nil {
+		w.counter.Add(ctx, 1, metric.WithAttributes(attribute.String("outcome", outcome)))
+	}
+}
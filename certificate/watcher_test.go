@@ -0,0 +1,120 @@
+package certificate_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/x-ethr/server/certificate"
+)
+
+// generate writes a self-signed certificate and key pair, with the given common-name, to the given path(s).
+func generate(t *testing.T, name, certificate, key string) {
+	t.Helper()
+
+	private, e := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if e != nil {
+		t.Fatalf("Unable to Generate Key: %v", e)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{name},
+	}
+
+	der, e := x509.CreateCertificate(rand.Reader, template, template, &private.PublicKey, private)
+	if e != nil {
+		t.Fatalf("Unable to Create Certificate: %v", e)
+	}
+
+	encoded, e := x509.MarshalECPrivateKey(private)
+	if e != nil {
+		t.Fatalf("Unable to Marshal Key: %v", e)
+	}
+
+	if e := os.WriteFile(certificate, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); e != nil {
+		t.Fatalf("Unable to Write Certificate: %v", e)
+	}
+
+	if e := os.WriteFile(key, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: encoded}), 0o600); e != nil {
+		t.Fatalf("Unable to Write Key: %v", e)
+	}
+}
+
+func Test(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	directory := t.TempDir()
+	certificates, key := filepath.Join(directory, "tls.crt"), filepath.Join(directory, "tls.key")
+
+	t.Run("Unavailable", func(t *testing.T) {
+		watcher, e := certificate.New(ctx, certificates, key)
+		if e == nil {
+			t.Fatalf("Expected Initial Load Error")
+		}
+
+		if _, e := watcher.GetCertificate(nil); e != certificate.Unavailable {
+			t.Errorf("Expected Unavailable Error, Received: %v", e)
+		}
+	})
+
+	t.Run("Reload", func(t *testing.T) {
+		generate(t, "initial.local", certificates, key)
+
+		watcher, e := certificate.New(ctx, certificates, key, func(o *certificate.Settings) { o.Interval = 10 * time.Millisecond })
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if v, _ := watcher.GetCertificate(nil); v == nil || v.Leaf.Subject.CommonName != "initial.local" {
+			t.Fatalf("Unexpected Initial Certificate")
+		}
+
+		generate(t, "rotated.local", certificates, key)
+
+		deadline := time.Now().Add(5 * time.Second)
+		for time.Now().Before(deadline) {
+			if v, _ := watcher.GetCertificate(nil); v != nil && v.Leaf.Subject.CommonName == "rotated.local" {
+				return
+			}
+
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		t.Errorf("Certificate Wasn't Reloaded")
+	})
+
+	t.Run("Invalid-Rotation", func(t *testing.T) {
+		generate(t, "valid.local", certificates, key)
+
+		watcher, e := certificate.New(ctx, certificates, key)
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if e := os.WriteFile(key, []byte("invalid"), 0o600); e != nil {
+			t.Fatalf("Unable to Write Key: %v", e)
+		}
+
+		if e := watcher.Reload(ctx); e == nil {
+			t.Errorf("Expected Reload Error")
+		}
+
+		if v, _ := watcher.GetCertificate(nil); v == nil || v.Leaf.Subject.CommonName != "valid.local" {
+			t.Errorf("Expected Previous Certificate to Remain Served")
+		}
+	})
+}
@@ -0,0 +1,263 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// connections tracks the state of an [Instance]'s connection(s) via [http.Server.ConnState], and reports the count(s) as
+// the "server.connections" (by state) and "server.connections.rejected" metrics. See [Instance.attributes] for the
+// instance's metric attribute(s).
+type connections struct {
+	mutex    sync.Mutex
+	states   map[net.Conn]http.ConnState
+	counts   map[http.ConnState]int64
+	hijacked int64
+
+	attributes   func() []attribute.KeyValue
+	registration metric.Registration
+	once         sync.Once // once unregisters the instance's gauge callback; see [connections.unregister].
+}
+
+// instruments represents the package's connection metric instrument(s), shared by every [Instance].
+type instruments struct {
+	meter    metric.Meter
+	gauge    metric.Int64ObservableGauge
+	rejected metric.Int64Counter
+}
+
+// measurements returns the package's connection metric instrument(s), instantiating them once.
+var measurements = sync.OnceValue(func() *instruments {
+	i := &instruments{meter: otel.Meter("github.com/x-ethr/server")}
+
+	var e error
+
+	i.gauge, e = i.meter.Int64ObservableGauge("server.connections", metric.WithDescription("The number of open connection(s), by state."))
+	if e != nil {
+		slog.Warn("Unable to Instantiate Connection Gauge", slog.String("error", e.Error()))
+	}
+
+	i.rejected, e = i.meter.Int64Counter("server.connections.rejected", metric.WithDescription("The number of connection(s) rejected by the per-client connection limit."))
+	if e != nil {
+		slog.Warn("Unable to Instantiate Rejected Connection Counter", slog.String("error", e.Error()))
+	}
+
+	return i
+})
+
+func tracker() *connections {
+	return &connections{
+		states:     make(map[net.Conn]http.ConnState),
+		counts:     make(map[http.ConnState]int64),
+		attributes: func() []attribute.KeyValue { return nil },
+	}
+}
+
+// observe registers the "server.connections" gauge callback, reporting the tracker's count(s) with the given attribute(s)
+// until [connections.unregister] is called.
+func (c *connections) observe(ctx context.Context, attributes func() []attribute.KeyValue) {
+	c.attributes = attributes
+
+	instruments := measurements()
+	if instruments.gauge == nil {
+		return
+	}
+
+	registration, e := instruments.meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		attributes := c.attributes()
+		for state, count := range c.snapshot() {
+			observer.ObserveInt64(instruments.gauge, count, metric.WithAttributes(append(attributes, attribute.String("state", state))...))
+		}
+
+		return nil
+	}, instruments.gauge)
+
+	if e != nil {
+		slog.WarnContext(ctx, "Unable to Register Connection Gauge Callback", slog.String("error", e.Error()))
+
+		return
+	}
+
+	c.registration = registration
+}
+
+// unregister removes the tracker's gauge callback, if registered, such that a stopped [Instance] no longer reports its
+// connection count(s).
+func (c *connections) unregister() {
+	c.once.Do(func() {
+		if c.registration == nil {
+			return
+		}
+
+		if e := c.registration.Unregister(); e != nil {
+			slog.Warn("Unable to Unregister Connection Gauge Callback", slog.String("error", e.Error()))
+		}
+	})
+}
+
+// reject records a connection rejected by the per-client connection limit.
+func (c *connections) reject(ctx context.Context) {
+	if rejected := measurements().rejected; rejected != nil {
+		rejected.Add(ctx, 1, metric.WithAttributes(c.attributes()...))
+	}
+}
+
+// track records a connection's state transition. Hijacked connection(s) are no longer tracked by the [http.Server], and
+// are therefore counted cumulatively.
+func (c *connections) track(connection net.Conn, state http.ConnState) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if previous, ok := c.states[connection]; ok {
+		c.counts[previous]--
+	}
+
+	switch state {
+	case http.StateClosed:
+		delete(c.states, connection)
+	case http.StateHijacked:
+		delete(c.states, connection)
+
+		c.hijacked++
+	default:
+		c.states[connection] = state
+		c.counts[state]++
+	}
+}
+
+// snapshot returns the connection count(s), keyed by state.
+func (c *connections) snapshot() map[string]int64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return map[string]int64{
+		http.StateNew.String():      c.counts[http.StateNew],
+		http.StateActive.String():   c.counts[http.StateActive],
+		http.StateIdle.String():     c.counts[http.StateIdle],
+		http.StateHijacked.String(): c.hijacked,
+	}
+}
+
+// limiter is a [net.Listener] enforcing the [Limits] configuration.
+//
+//   - Once [Limits.Connections] is reached, Accept blocks until a connection closes, leaving pending connection(s) in the
+//     kernel's backlog.
+//   - Connection(s) exceeding [Limits.Client] are accepted, then immediately closed.
+type limiter struct {
+	net.Listener
+
+	ctx       context.Context
+	semaphore chan struct{}
+	client    int
+
+	mutex   sync.Mutex
+	clients map[string]int
+
+	once   sync.Once
+	closed chan struct{}
+
+	tracker *connections
+}
+
+// limit wraps listener with a [limiter], or returns listener if no limit(s) are configured.
+func limit(ctx context.Context, listener net.Listener, limits Limits, tracker *connections) net.Listener {
+	if limits.Connections <= 0 && limits.Client <= 0 {
+		return listener
+	}
+
+	l := &limiter{Listener: listener, ctx: ctx, client: limits.Client, clients: make(map[string]int), closed: make(chan struct{}), tracker: tracker}
+	if limits.Connections > 0 {
+		l.semaphore = make(chan struct{}, limits.Connections)
+	}
+
+	return l
+}
+
+func (l *limiter) Accept() (net.Conn, error) {
+	for {
+		if l.semaphore != nil {
+			select {
+			case l.semaphore <- struct{}{}:
+			case <-l.closed:
+				return nil, net.ErrClosed
+			}
+		}
+
+		connection, e := l.Listener.Accept()
+		if e != nil {
+			l.release()
+
+			return nil, e
+		}
+
+		host, _, _ := net.SplitHostPort(connection.RemoteAddr().String())
+		if l.client > 0 && host != "" {
+			l.mutex.Lock()
+			if l.clients[host] >= l.client {
+				l.mutex.Unlock()
+
+				connection.Close()
+				l.release()
+
+				l.tracker.reject(l.ctx)
+
+				slog.DebugContext(l.ctx, "Rejected Connection - Client Limit Reached", slog.String("client", host))
+
+				continue
+			}
+
+			l.clients[host]++
+			l.mutex.Unlock()
+		}
+
+		return &limited{Conn: connection, release: func() {
+			if l.client > 0 && host != "" {
+				l.mutex.Lock()
+				if l.clients[host]--; l.clients[host] <= 0 {
+					delete(l.clients, host)
+				}
+				l.mutex.Unlock()
+			}
+
+			l.release()
+		}}, nil
+	}
+}
+
+func (l *limiter) Close() error {
+	l.once.Do(func() {
+		close(l.closed)
+	})
+
+	return l.Listener.Close()
+}
+
+// release frees a slot of the [Limits.Connections] semaphore, if configured.
+func (l *limiter) release() {
+	if l.semaphore != nil {
+		<-l.semaphore
+	}
+}
+
+// limited is a [net.Conn] that releases its [limiter] slot(s) upon the first call to Close.
+type limited struct {
+	net.Conn
+
+	once    sync.Once
+	release func()
+}
+
+func (c *limited) Close() error {
+	e := c.Conn.Close()
+
+	c.once.Do(c.release)
+
+	return e
+}
@@ -0,0 +1,55 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/x-ethr/server"
+	"github.com/x-ethr/server/health"
+	"github.com/x-ethr/server/middleware"
+	"github.com/x-ethr/server/middleware/timeout"
+)
+
+func Example() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	mux := server.New()
+
+	mux.Middleware(middleware.New().Path().Middleware)
+	mux.Middleware(middleware.New().Timeout().Configuration(func(options *timeout.Settings) {
+		options.Timeout = 30 * time.Second
+	}).Middleware)
+
+	mux.Register("GET /v1/service/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.PathValue("id")))
+	})
+
+	mux.Register("GET /health", server.Health, func(o *server.Options) {
+		o.Globals.Disable = true
+	})
+
+	mux.Register("GET /readyz", health.Readyz, func(o *server.Options) {
+		o.Globals.Disable = true
+	})
+
+	api := server.Server(ctx, mux, "8080")
+
+	lifecycle := server.Interrupt(ctx, cancel, api, func(s *server.Shutdown) {
+		s.Drain = 5 * time.Second
+		s.Grace = 15 * time.Second
+	})
+
+	lifecycle.Register(server.Hook{Name: "database", Priority: 10, Timeout: 5 * time.Second, Function: func(ctx context.Context) error {
+		return nil // e.g. close connection pool(s)
+	}})
+
+	// SIGHUP re-reads the TLS certificate (if configured), without a shutdown
+	lifecycle.OnReload(server.Hook{Name: "tls", Function: api.Reload})
+
+	api.ListenAndServe()
+
+	if e := lifecycle.Wait(); e != nil {
+		panic(e)
+	}
+}
@@ -123,7 +123,10 @@ func main() {
 	api := server.Server(ctx, mux, *port)
 
 	// Issue Cancellation Handler
-	server.Interrupt(ctx, cancel, api)
+	lifecycle := server.Interrupt(ctx, cancel, api, func(s *server.Shutdown) {
+		s.Drain = 5 * time.Second
+		s.Grace = 30 * time.Second
+	})
 
 	// Telemetry Setup
 	shutdown, e := telemetry.Setup(ctx, service, version, func(options *telemetry.Settings) {
@@ -139,9 +142,8 @@ func main() {
 		panic(e)
 	}
 
-	defer func() {
-		e = errors.Join(e, shutdown(ctx))
-	}()
+	// Flush telemetry once the server has stopped accepting request(s)
+	lifecycle.Register(server.Hook{Name: "telemetry", Priority: 100, Timeout: 10 * time.Second, Function: shutdown})
 
 	// <-- Blocking
 	if e := api.ListenAndServe(); e != nil && !(errors.Is(e, http.ErrServerClosed)) {
@@ -151,12 +153,13 @@ func main() {
 	}
 
 	// --> Exit
-	{
-		slog.InfoContext(ctx, "Graceful Shutdown Complete")
+	if e := lifecycle.Wait(); e != nil {
+		slog.ErrorContext(ctx, "Exception During Graceful Shutdown", slog.String("error", e.Error()))
 
-		// Waiter
-		<-ctx.Done()
+		os.Exit(99)
 	}
+
+	slog.InfoContext(ctx, "Graceful Shutdown Complete")
 }
 
 func init() {
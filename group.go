@@ -0,0 +1,64 @@
+package server
+
+import (
+	"net/http"
+	"sync"
+)
+
+// Group represents a set of [Mux] routes that share a common path prefix and middleware(s). Groups are established via
+// [Mux.Group], and may be nested via [Group.Group].
+//
+//   - Group middleware(s) are composed after the [Mux] global middleware(s), and before route-level [Options.Middleware].
+//   - Nested groups inherit the middleware(s) of their parent(s).
+type Group struct {
+	mux    *Mux
+	parent *Group
+
+	prefix string
+
+	mutex      sync.Mutex
+	middleware []func(http.Handler) http.Handler
+}
+
+// Middleware adds middleware(s) to all routes registered through the [Group], including those of nested groups.
+func (g *Group) Middleware(middlewares ...func(http.Handler) http.Handler) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.middleware = append(g.middleware, middlewares...)
+
+	g.mux.revision.Add(1)
+}
+
+// Register is the [Group] equivalent of [Mux.Register]; the group's prefix is joined with the pattern's path.
+func (g *Group) Register(pattern string, handler http.HandlerFunc, settings ...Variadic) {
+	g.Handle(pattern, handler, settings...)
+}
+
+// Handle is the [Group] equivalent of [Mux.Handle]; the group's prefix is joined with the pattern's path.
+func (g *Group) Handle(pattern string, handler http.Handler, settings ...Variadic) {
+	g.mux.register(g, pattern, handler, settings...)
+}
+
+// Group establishes a nested [Group] whose prefix is joined with the parent's.
+func (g *Group) Group(prefix string, fn func(g *Group)) {
+	fn(&Group{mux: g.mux, parent: g, prefix: join(g.prefix, clean(prefix)), middleware: make([]func(http.Handler) http.Handler, 0)})
+}
+
+// Mount is the [Group] equivalent of [Mux.Mount]; the group's prefix is joined with the mount's prefix.
+func (g *Group) Mount(prefix string, handler http.Handler, settings ...Variadic) {
+	g.mux.mount(g, prefix, handler, settings...)
+}
+
+// chain returns the group's middleware(s), preceded by all of its parent(s)' middleware(s).
+func (g *Group) chain() []func(http.Handler) http.Handler {
+	var middlewares []func(http.Handler) http.Handler
+	if g.parent != nil {
+		middlewares = g.parent.chain()
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	return append(middlewares, g.middleware...)
+}
@@ -3,17 +3,26 @@ package server
 import (
 	"encoding/json"
 	"net/http"
+
+	"github.com/x-ethr/server/health"
 )
 
+// Health is a static liveness handler. See the [health] package for probe(s) aggregating registered check(s).
 var Health http.HandlerFunc = func(w http.ResponseWriter, r *http.Request) {
 	response := map[string]string{
 		"status": "ok",
 	}
 
-	w.Header().Set("Content-Type", "Application/JSON")
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 
 	json.NewEncoder(w).Encode(response)
 
 	return
 }
+
+// Readiness is a readiness probe handler, equivalent to [health.Readyz]. Unlike [Health], Readiness responds with a 503
+// status code once the process begins draining (see [Shutdown.Drain]), or a critical readiness check fails.
+var Readiness http.HandlerFunc = func(w http.ResponseWriter, r *http.Request) {
+	health.Readyz(w, r)
+}
@@ -0,0 +1,121 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Probe represents the probe(s) a [Check] is evaluated by. Probes may be combined, e.g. Readiness | Startup.
+type Probe uint8
+
+const (
+	Liveness  Probe = 1 << iota // Liveness represents the liveness ("/healthz") probe. Failure(s) typically restart the process.
+	Readiness                   // Readiness represents the readiness ("/readyz") probe. Failure(s) remove the process from load-balancing.
+	Startup                     // Startup represents the startup ("/startupz") probe. Once passing, the startup probe always passes.
+)
+
+func (p Probe) String() string {
+	switch p {
+	case Liveness:
+		return "liveness"
+	case Readiness:
+		return "readiness"
+	case Startup:
+		return "startup"
+	default:
+		return "unknown"
+	}
+}
+
+// Check represents a named health check.
+type Check struct {
+	// Name represents the check's unique name, e.g. "postgres".
+	Name string
+
+	// Probes represents the probe(s) that evaluate the check. Defaults to [Readiness].
+	Probes Probe
+
+	// Critical represents whether the check's failure fails the probe (503), rather than degrading it.
+	Critical bool
+
+	// Timeout represents the check's deadline. Defaults to [Settings.Timeout].
+	Timeout time.Duration
+
+	// Cache represents the period a result is reused prior to re-evaluating the check. Defaults to 0 (no caching).
+	Cache time.Duration
+
+	// Function represents the check's function, returning a non-nil error if unhealthy.
+	Function func(ctx context.Context) error
+}
+
+// Result represents the outcome of a [Check].
+type Result struct {
+	Status   string `json:"status"`          // Status is either "ok" or "fail".
+	Critical bool   `json:"critical"`        // Critical reports whether the check is critical. See [Check.Critical].
+	Latency  string `json:"latency"`         // Latency represents the check's evaluation duration.
+	Error    string `json:"error,omitempty"` // Error represents the check's failure, if any.
+	Cached   bool   `json:"cached"`          // Cached reports whether the result was reused. See [Check.Cache].
+
+	timestamp time.Time
+	e         error
+}
+
+// Failed reports whether the check failed.
+func (r Result) Failed() bool {
+	return r.e != nil
+}
+
+// check represents a registered [Check], and its most recent [Result].
+type check struct {
+	Check
+
+	mutex  sync.Mutex
+	result *Result
+}
+
+// evaluate returns the check's cached result if still valid; otherwise, the check is evaluated using its timeout.
+func (c *check) evaluate(ctx context.Context, timeout time.Duration) Result {
+	c.mutex.Lock()
+	if c.Cache > 0 && c.result != nil && time.Since(c.result.timestamp) < c.Cache {
+		result := *c.result
+		c.mutex.Unlock()
+
+		result.Cached = true
+
+		return result
+	}
+	c.mutex.Unlock()
+
+	if c.Timeout > 0 {
+		timeout = c.Timeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+
+	channel := make(chan error, 1)
+	go func() {
+		channel <- c.Function(ctx)
+	}()
+
+	var e error
+	select {
+	case e = <-channel:
+	case <-ctx.Done():
+		e = ctx.Err()
+	}
+
+	result := Result{Status: "ok", Critical: c.Critical, Latency: time.Since(start).String(), timestamp: start, e: e}
+	if e != nil {
+		result.Status, result.Error = "fail", e.Error()
+	}
+
+	c.mutex.Lock()
+	c.result = &result
+	c.mutex.Unlock()
+
+	return result
+}
@@ -0,0 +1,134 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"runtime"
+)
+
+// TCP returns a check function that dials the TCP address, e.g. "localhost:5432".
+func TCP(address string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		connection, e := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+		if e != nil {
+			return e
+		}
+
+		return connection.Close()
+	}
+}
+
+// HTTP returns a check function that issues a GET request to url, expecting the given status code. A status of 0 accepts
+// any 2xx status code.
+//
+//   - Each check uses a dedicated [http.Client], isolated from [http.DefaultClient]; its request(s) are bound to the
+//     check's context, and therefore its [Check.Timeout].
+//   - At most 64 KiB of the response body is read (and discarded), such that the connection may be reused.
+func HTTP(url string, status int) func(ctx context.Context) error {
+	client := &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()}
+
+	return func(ctx context.Context) error {
+		request, e := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if e != nil {
+			return e
+		}
+
+		response, e := client.Do(request)
+		if e != nil {
+			return e
+		}
+
+		defer response.Body.Close()
+		defer io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+
+		if status == 0 && response.StatusCode >= 200 && response.StatusCode < 300 || response.StatusCode == status {
+			return nil
+		}
+
+		return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+}
+
+// Resolver represents a host name resolver, e.g. [net.Resolver].
+type Resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// DNS returns a check function that resolves host to at least one address using resolver. If resolver is nil,
+// [net.DefaultResolver] is used.
+func DNS(host string, resolver Resolver) func(ctx context.Context) error {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	return func(ctx context.Context) error {
+		addresses, e := resolver.LookupHost(ctx, host)
+		if e != nil {
+			return e
+		}
+
+		if len(addresses) == 0 {
+			return fmt.Errorf("no address(es) resolved for host: %s", host)
+		}
+
+		return nil
+	}
+}
+
+// Disk returns a check function that fails once the free space of the file-system containing path falls below minimum
+// bytes. Disk is only supported on Linux, macOS and FreeBSD.
+func Disk(path string, minimum uint64) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		available, e := free(path)
+		if e != nil {
+			return e
+		}
+
+		if available < minimum {
+			return fmt.Errorf("free disk space (%d bytes) below threshold (%d bytes)", available, minimum)
+		}
+
+		return nil
+	}
+}
+
+// Goroutines returns a check function that fails once the number of goroutines exceeds maximum.
+func Goroutines(maximum int) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if count := runtime.NumGoroutine(); count > maximum {
+			return fmt.Errorf("goroutine count (%d) exceeds threshold (%d)", count, maximum)
+		}
+
+		return nil
+	}
+}
+
+// Heap returns a check function that fails once the allocated heap exceeds maximum bytes.
+//
+//   - Reading memory statistics briefly stops the world; consider caching the check's result (see [Check.Cache]).
+func Heap(maximum uint64) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		var statistics runtime.MemStats
+
+		runtime.ReadMemStats(&statistics)
+
+		if statistics.HeapAlloc > maximum {
+			return fmt.Errorf("heap allocation (%d bytes) exceeds threshold (%d bytes)", statistics.HeapAlloc, maximum)
+		}
+
+		return nil
+	}
+}
+
+// Pinger represents a dependency capable of verifying its connection, e.g. [database/sql.DB].
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// Ping returns a check function that calls the dependency's PingContext method.
+func Ping(dependency Pinger) func(ctx context.Context) error {
+	return dependency.PingContext
+}
@@ -0,0 +1,153 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/x-ethr/server/health"
+)
+
+type pinger struct{ e error }
+
+type resolver map[string][]string
+
+func (r resolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if addresses, ok := r[host]; ok {
+		return addresses, nil
+	}
+
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func (p pinger) PingContext(context.Context) error { return p.e }
+
+func TestCheckers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("TCP", func(t *testing.T) {
+		listener, e := net.Listen("tcp", "127.0.0.1:0")
+		if e != nil {
+			t.Fatalf("Unable to Listen: %v", e)
+		}
+
+		address := listener.Addr().String()
+
+		if e := health.TCP(address)(ctx); e != nil {
+			t.Errorf("Unexpected Error: %v", e)
+		}
+
+		listener.Close()
+
+		if e := health.TCP(address)(ctx); e == nil {
+			t.Errorf("Expected Error Dialing Closed Listener")
+		}
+	})
+
+	t.Run("HTTP", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/teapot" {
+				w.WriteHeader(http.StatusTeapot)
+			}
+		}))
+
+		defer server.Close()
+
+		if e := health.HTTP(server.URL, 0)(ctx); e != nil {
+			t.Errorf("Unexpected Error: %v", e)
+		}
+
+		if e := health.HTTP(server.URL+"/teapot", http.StatusTeapot)(ctx); e != nil {
+			t.Errorf("Unexpected Error: %v", e)
+		}
+
+		if e := health.HTTP(server.URL+"/teapot", 0)(ctx); e == nil {
+			t.Errorf("Expected Unexpected Status Code Error")
+		}
+	})
+
+	t.Run("HTTP-Timeout", func(t *testing.T) {
+		release := make(chan struct{})
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+
+		defer server.Close()
+		defer close(release)
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		if e := health.HTTP(server.URL, 0)(ctx); !(errors.Is(e, context.DeadlineExceeded)) {
+			t.Errorf("Expected Deadline Error, Received: %v", e)
+		}
+	})
+
+	t.Run("DNS", func(t *testing.T) {
+		r := resolver{"database.internal": {"10.0.0.1"}, "empty.internal": {}}
+
+		if e := health.DNS("database.internal", r)(ctx); e != nil {
+			t.Errorf("Unexpected Error: %v", e)
+		}
+
+		for _, host := range []string{"empty.internal", "invalid.internal"} {
+			if e := health.DNS(host, r)(ctx); e == nil {
+				t.Errorf("Expected Resolution Error (%s)", host)
+			}
+		}
+	})
+
+	t.Run("Disk", func(t *testing.T) {
+		switch runtime.GOOS {
+		case "linux", "darwin", "freebsd":
+		default:
+			t.Skip("Disk Check Unsupported")
+		}
+
+		if e := health.Disk(t.TempDir(), 0)(ctx); e != nil {
+			t.Errorf("Unexpected Error: %v", e)
+		}
+
+		if e := health.Disk(t.TempDir(), math.MaxUint64)(ctx); e == nil {
+			t.Errorf("Expected Threshold Error")
+		}
+	})
+
+	t.Run("Goroutines", func(t *testing.T) {
+		if e := health.Goroutines(math.MaxInt)(ctx); e != nil {
+			t.Errorf("Unexpected Error: %v", e)
+		}
+
+		if e := health.Goroutines(0)(ctx); e == nil {
+			t.Errorf("Expected Threshold Error")
+		}
+	})
+
+	t.Run("Heap", func(t *testing.T) {
+		if e := health.Heap(math.MaxUint64)(ctx); e != nil {
+			t.Errorf("Unexpected Error: %v", e)
+		}
+
+		if e := health.Heap(1)(ctx); e == nil {
+			t.Errorf("Expected Threshold Error")
+		}
+	})
+
+	t.Run("Ping", func(t *testing.T) {
+		if e := health.Ping(pinger{})(ctx); e != nil {
+			t.Errorf("Unexpected Error: %v", e)
+		}
+
+		failure := errors.New("connection refused")
+		if e := health.Ping(pinger{e: failure})(ctx); !(errors.Is(e, failure)) {
+			t.Errorf("Expected Ping Error, Received: %v", e)
+		}
+	})
+}
@@ -0,0 +1,13 @@
+//go:build !(linux || darwin || freebsd)
+
+package health
+
+import (
+	"errors"
+	"runtime"
+)
+
+// free is unsupported on platform(s) without statfs(2).
+func free(string) (uint64, error) {
+	return 0, errors.New("disk check unsupported on " + runtime.GOOS)
+}
@@ -0,0 +1,15 @@
+//go:build linux || darwin || freebsd
+
+package health
+
+import "syscall"
+
+// free returns the bytes available to an unprivileged user on the file-system containing path.
+func free(path string) (uint64, error) {
+	var statistics syscall.Statfs_t
+	if e := syscall.Statfs(path, &statistics); e != nil {
+		return 0, e
+	}
+
+	return uint64(statistics.Bavail) * uint64(statistics.Bsize), nil
+}
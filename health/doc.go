@@ -0,0 +1,8 @@
+// Package health provides liveness, readiness and startup probe handler(s), aggregating the result(s) of named health
+// check(s) registered by the application's component(s) - e.g. database pool(s), cache(s), downstream service(s).
+//
+//   - Check(s) are evaluated concurrently, each bound by its own timeout, and optionally cached.
+//   - A failing critical check responds with a 503 status code; a failing non-critical check only degrades the response.
+//   - Ready-made check function(s) cover common dependencies: [TCP], [HTTP], [DNS], [Disk], [Goroutines], [Heap] and [Ping].
+//   - Once draining (see [Registry.Drain]), the readiness probe fails irrespective of its check(s).
+package health
@@ -0,0 +1,35 @@
+package health_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/x-ethr/server/health"
+)
+
+func Example() {
+	health.Register(health.Check{
+		Name:     "postgres",
+		Probes:   health.Readiness | health.Startup,
+		Critical: true,
+		Timeout:  time.Second,
+		Cache:    5 * time.Second,
+		Function: func(ctx context.Context) error {
+			return nil // e.g. pool.Ping(ctx)
+		},
+	})
+
+	health.Register(
+		health.Check{Name: "redis", Timeout: time.Second, Function: health.TCP("localhost:6379")},
+		health.Check{Name: "heap", Probes: health.Liveness, Cache: 30 * time.Second, Function: health.Heap(1 << 30)},
+	)
+
+	mux := http.NewServeMux()
+
+	mux.Handle("GET /healthz", health.Healthz)
+	mux.Handle("GET /readyz", health.Readyz)
+	mux.Handle("GET /startupz", health.Startupz)
+
+	http.ListenAndServe(":8080", mux)
+}
@@ -0,0 +1,20 @@
+package health
+
+import (
+	"time"
+
+	"github.com/x-ethr/server/internal/keystore"
+)
+
+type Settings struct {
+	// Timeout represents the default timeout of a [Check] that doesn't specify its own. Defaults to 5 seconds.
+	Timeout time.Duration `json:"timeout" yaml:"timeout"`
+}
+
+type Variadic keystore.Variadic[Settings]
+
+func settings() *Settings {
+	return &Settings{
+		Timeout: 5 * time.Second,
+	}
+}
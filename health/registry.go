@@ -0,0 +1,191 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// Registry represents a set of registered health check(s), and the probe handler(s) evaluating them.
+type Registry struct {
+	options *Settings
+
+	mutex  sync.Mutex
+	checks []*check
+
+	started  atomic.Bool
+	draining atomic.Bool
+}
+
+// Default represents the default [Registry]. The package-level function(s) and handler(s) are thin wrapper(s) over
+// Default's method(s); process(es) using a [New] registry should call its method(s) directly.
+var Default = New()
+
+// New constructs a [Registry] using the optional [Variadic] settings. See [Settings] for default(s).
+func New(options ...Variadic) *Registry {
+	var o = settings()
+	for _, option := range options {
+		option(o)
+	}
+
+	return &Registry{options: o, checks: make([]*check, 0)}
+}
+
+// Register adds check(s) to the registry. Register panics if a check's name is empty, already registered, or the check's
+// function is nil.
+func (r *Registry) Register(checks ...Check) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, c := range checks {
+		if c.Name == "" || c.Function == nil {
+			panic("health: check requires a name and function")
+		}
+
+		for index := range r.checks {
+			if r.checks[index].Name == c.Name {
+				panic(fmt.Sprintf("health: check (%s) already registered", c.Name))
+			}
+		}
+
+		if c.Probes == 0 {
+			c.Probes = Readiness
+		}
+
+		r.checks = append(r.checks, &check{Check: c})
+	}
+}
+
+// Drain fails the readiness probe irrespective of its check(s), in preparation for a shutdown.
+func (r *Registry) Drain() {
+	r.draining.Store(true)
+}
+
+// Draining reports whether the registry is draining. See [Registry.Drain].
+func (r *Registry) Draining() bool {
+	return r.draining.Load()
+}
+
+// Reset clears the registry's drain (see [Registry.Drain]) and startup state, retaining its check(s); e.g. following an
+// abandoned shutdown, or between test(s).
+func (r *Registry) Reset() {
+	r.draining.Store(false)
+	r.started.Store(false)
+}
+
+// Report represents the aggregated outcome of a probe's check(s).
+type Report struct {
+	Status string            `json:"status"` // Status is one of "ok", "degraded", "fail", or "draining".
+	Checks map[string]Result `json:"checks"`
+}
+
+// Evaluate concurrently evaluates every check registered for the probe, returning the aggregated [Report] and its
+// corresponding HTTP status code.
+func (r *Registry) Evaluate(ctx context.Context, probe Probe) (Report, int) {
+	report := Report{Status: "ok", Checks: make(map[string]Result)}
+
+	if probe == Readiness && r.Draining() {
+		report.Status = "draining"
+
+		return report, http.StatusServiceUnavailable
+	}
+
+	if probe == Startup && r.started.Load() {
+		return report, http.StatusOK
+	}
+
+	r.mutex.Lock()
+	checks := make([]*check, 0, len(r.checks))
+	for index := range r.checks {
+		if r.checks[index].Probes&probe != 0 {
+			checks = append(checks, r.checks[index])
+		}
+	}
+	r.mutex.Unlock()
+
+	results := make([]Result, len(checks))
+
+	var group sync.WaitGroup
+	for index := range checks {
+		group.Add(1)
+		go func(index int) {
+			defer group.Done()
+
+			results[index] = checks[index].evaluate(ctx, r.options.Timeout)
+		}(index)
+	}
+
+	group.Wait()
+
+	status := http.StatusOK
+	for index := range checks {
+		result := results[index]
+
+		report.Checks[checks[index].Name] = result
+
+		if !(result.Failed()) {
+			continue
+		}
+
+		slog.WarnContext(ctx, "Health Check Failure", slog.String("probe", probe.String()), slog.String("check", checks[index].Name), slog.String("error", result.Error))
+
+		if result.Critical {
+			status, report.Status = http.StatusServiceUnavailable, "fail"
+		} else if report.Status == "ok" {
+			report.Status = "degraded"
+		}
+	}
+
+	if probe == Startup && status == http.StatusOK {
+		r.started.Store(true)
+	}
+
+	return report, status
+}
+
+// Handler returns an [http.Handler] responding with the probe's JSON [Report].
+func (r *Registry) Handler(probe Probe) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+		report, status := r.Evaluate(request.Context(), probe)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(status)
+
+		json.NewEncoder(w).Encode(report)
+	})
+}
+
+// Register is equivalent to Default.Register(checks...). See [Registry.Register].
+func Register(checks ...Check) {
+	Default.Register(checks...)
+}
+
+// Drain is equivalent to Default.Drain(). See [Registry.Drain].
+func Drain() {
+	Default.Drain()
+}
+
+// Draining is equivalent to Default.Draining(). See [Registry.Draining].
+func Draining() bool {
+	return Default.Draining()
+}
+
+// Healthz is the [Default] registry's liveness probe handler.
+var Healthz http.HandlerFunc = func(w http.ResponseWriter, r *http.Request) {
+	Default.Handler(Liveness).ServeHTTP(w, r)
+}
+
+// Readyz is the [Default] registry's readiness probe handler.
+var Readyz http.HandlerFunc = func(w http.ResponseWriter, r *http.Request) {
+	Default.Handler(Readiness).ServeHTTP(w, r)
+}
+
+// Startupz is the [Default] registry's startup probe handler.
+var Startupz http.HandlerFunc = func(w http.ResponseWriter, r *http.Request) {
+	Default.Handler(Startup).ServeHTTP(w, r)
+}
@@ -0,0 +1,139 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/x-ethr/server/health"
+)
+
+func TestRegistry(t *testing.T) {
+	probe := func(t *testing.T, registry *health.Registry, p health.Probe) (health.Report, int) {
+		t.Helper()
+
+		recorder := httptest.NewRecorder()
+
+		registry.Handler(p).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		if v := recorder.Header().Get("Content-Type"); v != "application/json" {
+			t.Errorf("Unexpected Content-Type: %s", v)
+		}
+
+		var report health.Report
+		if e := json.NewDecoder(recorder.Body).Decode(&report); e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		return report, recorder.Code
+	}
+
+	failure := func(context.Context) error { return errors.New("unavailable") }
+	success := func(context.Context) error { return nil }
+
+	t.Run("Critical", func(t *testing.T) {
+		registry := health.New()
+		registry.Register(
+			health.Check{Name: "database", Critical: true, Function: failure},
+			health.Check{Name: "cache", Function: success},
+		)
+
+		report, status := probe(t, registry, health.Readiness)
+		if status != http.StatusServiceUnavailable || report.Status != "fail" {
+			t.Errorf("Expected Failing Probe, Received: %d (%s)", status, report.Status)
+		}
+
+		if report.Checks["database"].Error != "unavailable" || report.Checks["cache"].Status != "ok" {
+			t.Errorf("Unexpected Check Result(s): %+v", report.Checks)
+		}
+
+		if _, status := probe(t, registry, health.Liveness); status != http.StatusOK {
+			t.Errorf("Expected Liveness Probe Unaffected by Readiness Check(s), Received: %d", status)
+		}
+	})
+
+	t.Run("Degraded", func(t *testing.T) {
+		registry := health.New()
+		registry.Register(health.Check{Name: "recommendations", Function: failure})
+
+		report, status := probe(t, registry, health.Readiness)
+		if status != http.StatusOK || report.Status != "degraded" {
+			t.Errorf("Expected Degraded Probe, Received: %d (%s)", status, report.Status)
+		}
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		registry := health.New()
+		registry.Register(health.Check{Name: "slow", Critical: true, Timeout: 10 * time.Millisecond, Function: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}})
+
+		report, status := probe(t, registry, health.Readiness)
+		if status != http.StatusServiceUnavailable || report.Checks["slow"].Error != context.DeadlineExceeded.Error() {
+			t.Errorf("Expected Timeout Failure, Received: %d (%+v)", status, report.Checks["slow"])
+		}
+	})
+
+	t.Run("Cache", func(t *testing.T) {
+		var evaluations int
+
+		registry := health.New()
+		registry.Register(health.Check{Name: "cached", Cache: time.Minute, Function: func(context.Context) error {
+			evaluations++
+			return nil
+		}})
+
+		probe(t, registry, health.Readiness)
+		report, _ := probe(t, registry, health.Readiness)
+
+		if evaluations != 1 || !(report.Checks["cached"].Cached) {
+			t.Errorf("Expected Cached Result, Received %d Evaluation(s)", evaluations)
+		}
+	})
+
+	t.Run("Startup", func(t *testing.T) {
+		ready := false
+
+		registry := health.New()
+		registry.Register(health.Check{Name: "migrations", Probes: health.Startup, Critical: true, Function: func(context.Context) error {
+			if !(ready) {
+				return errors.New("pending")
+			}
+
+			return nil
+		}})
+
+		if _, status := probe(t, registry, health.Startup); status != http.StatusServiceUnavailable {
+			t.Errorf("Expected Failing Startup Probe, Received: %d", status)
+		}
+
+		ready = true
+		probe(t, registry, health.Startup)
+
+		ready = false
+		if _, status := probe(t, registry, health.Startup); status != http.StatusOK {
+			t.Errorf("Expected Startup Probe to Remain Passing, Received: %d", status)
+		}
+	})
+
+	t.Run("Drain", func(t *testing.T) {
+		registry := health.New()
+		registry.Drain()
+
+		report, status := probe(t, registry, health.Readiness)
+		if status != http.StatusServiceUnavailable || report.Status != "draining" {
+			t.Errorf("Expected Draining Probe, Received: %d (%s)", status, report.Status)
+		}
+
+		registry.Reset()
+
+		if _, status := probe(t, registry, health.Readiness); status != http.StatusOK || registry.Draining() {
+			t.Errorf("Expected Ready Probe Following Reset, Received: %d", status)
+		}
+	})
+}
@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/x-ethr/server/certificate"
+)
+
+// Instance represents an [http.Server] and its [Listener] lifecycle. See [Server] for construction.
+//
+//   - Note that [Instance.Addr] shadows the embedded [http.Server.Addr] field; use the Server field directly to access the
+//     configured address.
+type Instance struct {
+	*http.Server
+
+	ctx         context.Context
+	name        string // name represents the instance's [Supervisor] name, if supervised; see [Instance.key].
+	settings    *Settings
+	watcher     *certificate.Watcher
+	connections *connections
+	failure     error // failure represents an invalid configuration detected by [Server], returned by [Instance.Listen].
+
+	mutex     sync.Mutex
+	listener  net.Listener // listener represents the established listener; see [Instance.listeners].
+	limited   net.Listener // limited represents the served listener, subject to [Settings.Limits].
+	listening chan struct{}
+	once      sync.Once // once closes listening upon the first attempt to listen, successful or not.
+}
+
+// Listen establishes the instance's [net.Listener] per [Settings.Listener], subject to the [Settings.Limits]. Calling
+// Listen more than once returns the existing listener. Listen returns an error, without listening, if the instance's
+// configuration is invalid (see [Server]).
+func (i *Instance) Listen() (net.Listener, error) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	defer i.once.Do(func() {
+		close(i.listening)
+	})
+
+	if i.failure != nil {
+		return nil, i.failure
+	} else if i.limited != nil {
+		return i.limited, nil
+	}
+
+	listener := adopt(i.key())
+	if listener != nil {
+		slog.InfoContext(i.ctx, "Inherited Listener", slog.String("listener", i.key()))
+	} else {
+		var e error
+		if listener, e = listen(&i.settings.Listener, i.Server.Addr); e != nil {
+			return nil, e
+		}
+	}
+
+	i.listener = listener
+	i.limited = limit(i.ctx, listener, i.settings.Limits, i.connections)
+
+	slog.InfoContext(i.ctx, "Server Listening", slog.String("network", listener.Addr().Network()), slog.String("address", listener.Addr().String()))
+
+	return i.limited, nil
+}
+
+// Connections returns the instance's connection count(s), keyed by [http.ConnState] name ("new", "active", "idle"). The
+// "hijacked" count is cumulative.
+func (i *Instance) Connections() map[string]int64 {
+	return i.connections.snapshot()
+}
+
+// Shutdown gracefully shuts down the server (see [http.Server.Shutdown]), and stops reporting the instance's connection
+// metric(s).
+func (i *Instance) Shutdown(ctx context.Context) error {
+	defer i.connections.unregister()
+
+	return i.Server.Shutdown(ctx)
+}
+
+// Close immediately closes the server (see [http.Server.Close]), and stops reporting the instance's connection metric(s).
+func (i *Instance) Close() error {
+	defer i.connections.unregister()
+
+	return i.Server.Close()
+}
+
+// attributes returns the instance's metric attribute(s): its listener address - never empty, and distinct across
+// instance(s) listening concurrently - and its [Settings.Name], if set.
+func (i *Instance) attributes() []attribute.KeyValue {
+	address := i.Server.Addr
+	if addr := i.Addr(); addr != nil {
+		address = addr.Network() + "://" + addr.String()
+	}
+
+	attributes := []attribute.KeyValue{attribute.String("server.listener", address)}
+	if name := i.settings.Name; name != "" {
+		attributes = append(attributes, attribute.String("server.name", name))
+	}
+
+	return attributes
+}
+
+// Addr returns the listener's address, or nil if the instance isn't yet listening. See [Instance.Listening].
+func (i *Instance) Addr() net.Addr {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if i.listener == nil {
+		return nil
+	}
+
+	return i.listener.Addr()
+}
+
+// Listening returns a channel that's closed once the instance's first attempt to listen has completed, successfully or
+// not. If the attempt failed, [Instance.Addr] returns nil, and the failure is returned by [Instance.Listen] (or
+// [Instance.ListenAndServe]).
+func (i *Instance) Listening() <-chan struct{} {
+	return i.listening
+}
+
+// ListenAndServe establishes the instance's listener (see [Instance.Listen]), and serves HTTP - or HTTPS, if [Settings.TLS]
+// was configured. ListenAndServe always returns a non-nil error; after [http.Server.Shutdown] or [http.Server.Close],
+// the returned error is [http.ErrServerClosed].
+func (i *Instance) ListenAndServe() error {
+	listener, e := i.Listen()
+	if e != nil {
+		return e
+	}
+
+	if i.Server.TLSConfig != nil && i.Server.TLSConfig.GetCertificate != nil {
+		return i.Server.ServeTLS(listener, "", "")
+	}
+
+	return i.Server.Serve(listener)
+}
+
+// key identifies the instance's listener configuration, matching listener(s) across an upgrade. See [Lifecycle.Upgrade].
+//
+//   - The configured address alone doesn't identify a listener (e.g. two instances on port "0"); the key is therefore
+//     qualified by the instance's [Supervisor] name, or else its [Settings.Name], if either is set.
+func (i *Instance) key() string {
+	var key string
+	switch network := i.settings.Listener.Network; network {
+	case "unix":
+		key = network + "://" + i.settings.Listener.Path
+	case "systemd":
+		key = network + "://" + i.settings.Listener.Name
+	case "":
+		key = "tcp://" + i.Server.Addr
+	default:
+		key = network + "://" + i.Server.Addr
+	}
+
+	if name := i.name; name != "" {
+		return key + "#" + name
+	} else if name := i.settings.Name; name != "" {
+		return key + "#" + name
+	}
+
+	return key
+}
+
+// listeners returns the instance's listener, if listening, keyed by [Instance.key].
+func (i *Instance) listeners() (map[string]net.Listener, error) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	listeners := make(map[string]net.Listener)
+	if i.listener != nil {
+		listeners[i.key()] = i.listener
+	}
+
+	return listeners, nil
+}
+
+// Reload forces the instance's TLS certificate and key file(s) to be re-read (see [certificate.Watcher.Reload]). Reload
+// is a no-op if [Settings.TLS] wasn't configured. Typically, Reload is registered via [Lifecycle.OnReload].
+func (i *Instance) Reload(ctx context.Context) error {
+	if i.watcher == nil {
+		return nil
+	}
+
+	return i.watcher.Reload(ctx)
+}
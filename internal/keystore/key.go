@@ -50,6 +50,16 @@ type Store interface {
 
 	// State represents the context.Context key: "state". See [state.Implementation] for the middleware.
 	State() Key
+
+	// Route represents the context.Context key: "route". See [route.Implementation] for the middleware.
+	//
+	//	- Used for storing the [server.Mux] route that matched the request.
+	Route() Key
+
+	// Peer represents the context.Context key: "peer". See [peer.Implementation] for the middleware.
+	//
+	//	- Used for storing the verified mutual-TLS client certificate's identity.
+	Peer() Key
 }
 
 type store struct{}
@@ -84,6 +94,10 @@ func (s store) Tracer() Key { return "tracer" }
 
 func (s store) State() Key { return "state" }
 
+func (s store) Route() Key { return "route" }
+
+func (s store) Peer() Key { return "peer" }
+
 var s = store{}
 
 func Keys() Store {
@@ -2,9 +2,13 @@ package writer
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"log/slog"
 	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
 )
 
 type Writer struct {
@@ -12,6 +16,9 @@ type Writer struct {
 
 	status int
 	buffer bytes.Buffer
+
+	streaming bool  // streaming bypasses the buffer; see [Stream].
+	size      int64 // size represents the number of bytes written while streaming.
 }
 
 func Handle(next http.Handler) http.Handler {
@@ -27,23 +34,93 @@ func Handle(next http.Handler) http.Handler {
 			slog.ErrorContext(ctx, "Error Writing Response", slog.String("error", e.Error()))
 		}
 
-		slog.InfoContext(ctx, "Response Writer", slog.String("hostname", r.URL.Hostname()), slog.String("path", r.URL.Path), slog.Int64("size", size), slog.Int("status", instance.status))
+		slog.InfoContext(ctx, "Response Writer", slog.String("hostname", r.URL.Hostname()), slog.String("path", r.URL.Path), slog.String("route", route(ctx)), slog.Int64("size", size), slog.Int("status", instance.status))
 	})
 }
 
+// route returns the low-cardinality "http.route" attribute added to the request's [otelhttp.Labeler] by the route middleware,
+// or an empty string if the request wasn't matched by a registered route.
+func route(ctx context.Context) string {
+	labeler, ok := otelhttp.LabelerFromContext(ctx)
+	if !(ok) {
+		return ""
+	}
+
+	for _, attribute := range labeler.Get() {
+		if attribute.Key == semconv.HTTPRouteKey {
+			return attribute.Value.AsString()
+		}
+	}
+
+	return ""
+}
+
+// Stream switches the [Writer] wrapped by w, if any, to write directly to the underlying [http.ResponseWriter] rather
+// than buffering the response; e.g. for long-running or large response(s). Stream must be called prior to writing the
+// response, and reports whether a [Writer] was found.
+func Stream(w http.ResponseWriter) bool {
+	for {
+		switch v := w.(type) {
+		case *Writer:
+			v.streaming = true
+			return true
+		case interface{ Unwrap() http.ResponseWriter }:
+			w = v.Unwrap()
+		default:
+			return false
+		}
+	}
+}
+
 func (w *Writer) Header() http.Header {
 	return w.w.Header()
 }
 
 func (w *Writer) Write(bytes []byte) (int, error) {
+	if w.streaming {
+		size, e := w.w.Write(bytes)
+		w.size += int64(size)
+
+		return size, e
+	}
+
 	return w.buffer.Write(bytes)
 }
 
 func (w *Writer) WriteHeader(status int) {
 	w.status = status
+
+	if w.streaming {
+		w.w.WriteHeader(status)
+	}
+}
+
+// FlushError writes the buffered response, if any, and flushes the underlying [http.ResponseWriter]; subsequent write(s)
+// are streamed (see [Stream]). FlushError takes precedence over [Writer.Unwrap] for [http.ResponseController.Flush].
+func (w *Writer) FlushError() error {
+	if !(w.streaming) {
+		size, e := w.Done()
+
+		w.streaming, w.size = true, size
+
+		if e != nil {
+			return e
+		}
+	}
+
+	return http.NewResponseController(w.w).Flush()
+}
+
+// Unwrap returns the underlying [http.ResponseWriter], see [http.ResponseController].
+func (w *Writer) Unwrap() http.ResponseWriter {
+	return w.w
 }
 
 func (w *Writer) Done() (int64, error) {
+	if w.streaming {
+		return w.size, nil
+	}
+
 	if w.status >= 100 {
 		w.w.WriteHeader(w.status)
 	}
@@ -5,46 +5,360 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"net/http"
 	"os"
 	"os/signal"
+	"sort"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"golang.org/x/crypto/ssh/terminal"
+
+	"github.com/x-ethr/server/health"
 )
 
-// Interrupt is a graceful interrupt + signal handler for an HTTP server.
-func Interrupt(ctx context.Context, cancel context.CancelFunc, server *http.Server) {
+// Shutdowner represents a server capable of a graceful shutdown, e.g. an [http.Server] or [Instance].
+type Shutdowner interface {
+	Shutdown(ctx context.Context) error
+}
+
+// Hook represents a named shutdown or reload function. See [Lifecycle.Register] and [Lifecycle.OnReload].
+type Hook struct {
+	// Name represents the hook's name, used for logging and error reporting.
+	Name string
+
+	// Priority represents the hook's order of evaluation. Hooks are evaluated in ascending order of priority; hooks
+	// sharing a priority are evaluated in order of registration.
+	Priority int
+
+	// Timeout represents the hook's individual deadline. Defaults to 0, which only bounds the hook by the remainder of the
+	// [Shutdown.Grace] period.
+	Timeout time.Duration
+
+	// Function represents the hook's function. Function should return promptly once ctx is done.
+	Function func(ctx context.Context) error
+}
+
+// Shutdown represents the [Interrupt] configuration.
+type Shutdown struct {
+	// Grace represents the total period allotted to shutting down the server and evaluating all registered [Hook](s).
+	// Defaults to 30 seconds.
+	//
+	//   - The server's shutdown and the hook(s) share a single deadline: hook(s) only receive what's left of the grace
+	//     period once the server has shut down, e.g. 5 seconds of a 30-second period if the shutdown took 25 seconds.
+	Grace time.Duration
+
+	// Drain represents the period to continue serving request(s) after a shutdown is initiated, but prior to shutting down
+	// the server. While draining, the [Shutdown.Health] registry's readiness probe (e.g. [Readiness]) fails and response(s)
+	// include a "Connection: close" header, allowing
+	// load-balancer(s) and service discovery to stop routing traffic to the server. Drain isn't counted against the
+	// [Shutdown.Grace] period. Defaults to 0 (no drain).
+	//
+	//   - A second shutdown signal, or the cancellation of the [Interrupt] context, ends the drain early.
+	Drain time.Duration
+
+	// Signals represents the os signal(s) that initiate a shutdown. Defaults to SIGINT, SIGTERM and SIGQUIT. An empty
+	// slice disables signal handling, leaving [Lifecycle.Stop] as the only trigger.
+	Signals []os.Signal
+
+	// Reloads represents the os signal(s) that evaluate the [Lifecycle.OnReload] hook(s), rather than initiating a shutdown.
+	// Defaults to SIGHUP.
+	Reloads []os.Signal
+
+	// Upgrade represents the zero-downtime binary upgrade configuration. Disabled by default.
+	Upgrade Upgrade
+
+	// Health represents the [health.Registry] drained once a shutdown is initiated; it should be the registry serving the
+	// process's readiness probe. Defaults to [health.Default].
+	Health *health.Registry
+}
+
+// Graceful represents a functional [Shutdown] setting.
+type Graceful func(s *Shutdown)
+
+// Lifecycle represents the server's shutdown lifecycle, as established by [Interrupt].
+type Lifecycle struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	server Shutdowner
+
+	configuration *Shutdown
+
+	mutex   sync.Mutex
+	hooks   []Hook
+	reloads []Hook
+
+	trigger chan struct{}
+	once    sync.Once
+
+	stopping  chan struct{} // stopping is closed once the shutdown begins.
+	upgrading atomic.Bool
+
+	done chan struct{}
+	e    error
+}
+
+// Interrupt is a graceful interrupt + signal handler for an HTTP server. Upon receiving one of the [Shutdown.Signals], a
+// call to [Lifecycle.Stop], or the cancellation of ctx (e.g. following a failed [Instance.ListenAndServe]), Interrupt:
+//
+//  1. Drains the server for the [Shutdown.Drain] period.
+//  2. Gracefully shuts down the server.
+//  3. Evaluates all registered [Hook](s), in order of [Hook.Priority].
+//  4. Calls cancel.
+//
+// Errors, including exceeding the [Shutdown.Grace] period, are reported via [Lifecycle.Wait] rather than exiting the process.
+func Interrupt(ctx context.Context, cancel context.CancelFunc, server Shutdowner, configuration ...Graceful) *Lifecycle {
+	s := &Shutdown{
+		Grace:   30 * time.Second,
+		Signals: []os.Signal{syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT},
+		Reloads: []os.Signal{syscall.SIGHUP},
+		Health:  health.Default,
+	}
+
+	for _, option := range configuration {
+		option(s)
+	}
+
+	if s.Health == nil {
+		s.Health = health.Default
+	}
+
+	l := &Lifecycle{
+		ctx:           ctx,
+		cancel:        cancel,
+		server:        server,
+		configuration: s,
+		hooks:         make([]Hook, 0),
+		reloads:       make([]Hook, 0),
+		trigger:       make(chan struct{}),
+		stopping:      make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+
 	// Listen for syscall signals for process to interrupt/quit
 	interrupt := make(chan os.Signal, 1)
-	signal.Notify(interrupt, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+	if len(s.Signals) > 0 {
+		signal.Notify(interrupt, s.Signals...)
+	}
+	reload := make(chan os.Signal, 1)
+	if len(s.Reloads) > 0 {
+		signal.Notify(reload, s.Reloads...)
+	}
+
+	upgrade := make(chan os.Signal, 1)
+	if len(s.Upgrade.Signals) > 0 {
+		signal.Notify(upgrade, s.Upgrade.Signals...)
+	}
+
 	go func() {
-		<-interrupt
+		defer signal.Stop(interrupt)
+		defer signal.Stop(reload)
+		defer signal.Stop(upgrade)
 
-		if terminal.IsTerminal(int(os.Stdout.Fd())) {
-			fmt.Print("\r")
+		for {
+			select {
+			case <-reload:
+				l.Reload(ctx)
+
+				continue
+			case <-upgrade:
+				// The upgrade waits for the new process's readiness; evaluating it asynchronously leaves the loop free to
+				// handle a shutdown signal meanwhile. Upon success, the shutdown is initiated via Lifecycle.Stop.
+				go l.Upgrade(ctx)
+
+				continue
+			case <-interrupt:
+				if terminal.IsTerminal(int(os.Stdout.Fd())) {
+					fmt.Print("\r")
+				}
+			case <-l.trigger:
+			case <-ctx.Done():
+			}
+
+			break
 		}
 
-		slog.DebugContext(ctx, "Initializing Server Shutdown ...")
+		l.shutdown(interrupt)
+	}()
+
+	return l
+}
 
-		// Shutdown signal with grace period of 30 seconds
-		shutdown, timeout := context.WithTimeout(ctx, 30*time.Second)
-		defer timeout()
-		go func() {
-			<-shutdown.Done()
-			if errors.Is(shutdown.Err(), context.DeadlineExceeded) {
-				slog.Log(ctx, slog.LevelError, "Graceful Server Shutdown Timeout - Forcing an Exit ...")
+// Register adds shutdown hook(s) to the lifecycle. Hooks registered after a shutdown has been initiated aren't evaluated.
+func (l *Lifecycle) Register(hooks ...Hook) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
 
-				os.Exit(99)
-			}
-		}()
+	l.hooks = append(l.hooks, hooks...)
+}
+
+// OnReload adds reload hook(s) to the lifecycle, e.g. re-reading TLS certificate(s) (see [Instance.Reload]), log
+// level(s), or feature flag(s). Reload hooks are evaluated upon receiving one of the [Shutdown.Reloads] signals, or a call
+// to [Lifecycle.Reload].
+func (l *Lifecycle) OnReload(hooks ...Hook) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.reloads = append(l.reloads, hooks...)
+}
+
+// Reload evaluates every reload hook, in order of [Hook.Priority], logging each outcome. A failing hook doesn't prevent
+// the evaluation of subsequent hook(s); all errors are joined and returned.
+func (l *Lifecycle) Reload(ctx context.Context) error {
+	l.mutex.Lock()
+	hooks := append(make([]Hook, 0, len(l.reloads)), l.reloads...)
+	l.mutex.Unlock()
+
+	slog.InfoContext(ctx, "Reloading Configuration", slog.Int("hooks", len(hooks)))
+
+	var errs []error
+	for _, hook := range order(hooks) {
+		if e := hook.evaluate(ctx); e != nil {
+			slog.ErrorContext(ctx, "Exception During Reload Hook", slog.String("hook", hook.Name), slog.String("error", e.Error()))
+
+			errs = append(errs, e)
 
-		// Trigger graceful shutdown
-		if e := server.Shutdown(shutdown); e != nil {
-			slog.ErrorContext(ctx, "Exception During Server Shutdown", slog.String("error", e.Error()))
+			continue
 		}
 
-		cancel()
+		slog.InfoContext(ctx, "Reload Hook Complete", slog.String("hook", hook.Name))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Stop initiates a shutdown without an os signal. Calling Stop more than once has no additional effect.
+func (l *Lifecycle) Stop() {
+	l.once.Do(func() {
+		close(l.trigger)
+	})
+}
+
+// Wait blocks until the shutdown has completed, returning any error(s) from the server's shutdown and the registered [Hook](s).
+func (l *Lifecycle) Wait() error {
+	<-l.done
+
+	return l.e
+}
+
+// Done returns a channel that's closed once the shutdown has completed.
+func (l *Lifecycle) Done() <-chan struct{} {
+	return l.done
+}
+
+// shutdown drains and shuts down the server, then evaluates the registered hook(s). A signal received on interrupt during
+// the drain ends it early; see [Lifecycle.drain].
+func (l *Lifecycle) shutdown(interrupt <-chan os.Signal) {
+	defer close(l.done)
+	defer l.cancel()
+
+	close(l.stopping)
+
+	slog.DebugContext(l.ctx, "Initializing Server Shutdown ...", slog.Duration("grace", l.configuration.Grace))
+
+	l.drain(interrupt)
+
+	// The grace period is isolated from the parent's cancellation, which commonly coincides with the shutdown itself.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(l.ctx), l.configuration.Grace)
+	defer cancel()
+
+	var errs []error
+
+	// Trigger graceful shutdown
+	if e := l.server.Shutdown(ctx); e != nil {
+		slog.ErrorContext(l.ctx, "Exception During Server Shutdown", slog.String("error", e.Error()))
+
+		errs = append(errs, fmt.Errorf("server shutdown: %w", e))
+	}
+
+	l.mutex.Lock()
+	hooks := append(make([]Hook, 0, len(l.hooks)), l.hooks...)
+	l.mutex.Unlock()
+
+	// Hooks intentionally share the grace period's ctx, and therefore only receive the remainder of the server's shutdown.
+	for _, hook := range order(hooks) {
+		if e := hook.evaluate(ctx); e != nil {
+			slog.ErrorContext(l.ctx, "Exception During Shutdown Hook", slog.String("hook", hook.Name), slog.String("error", e.Error()))
+
+			errs = append(errs, e)
+
+			continue
+		}
+
+		slog.DebugContext(l.ctx, "Shutdown Hook Complete", slog.String("hook", hook.Name))
+	}
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		slog.ErrorContext(l.ctx, "Graceful Server Shutdown Timeout", slog.Duration("grace", l.configuration.Grace))
+	}
+
+	l.e = errors.Join(errs...)
+}
+
+// drain fails the [Shutdown.Health] registry's readiness probe, disables keep-alive(s) such that response(s) include a
+// "Connection: close" header, and continues serving for the [Shutdown.Drain] period - or until a second signal is
+// received on interrupt, or the lifecycle's context is cancelled.
+func (l *Lifecycle) drain(interrupt <-chan os.Signal) {
+	l.configuration.Health.Drain()
+
+	if v, ok := l.server.(interface{ SetKeepAlivesEnabled(v bool) }); ok {
+		v.SetKeepAlivesEnabled(false)
+	}
+
+	if l.configuration.Drain <= 0 {
+		return
+	}
+
+	slog.InfoContext(l.ctx, "Draining Server", slog.Duration("drain", l.configuration.Drain))
+
+	timer := time.NewTimer(l.configuration.Drain)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-interrupt:
+		slog.WarnContext(l.ctx, "Drain Interrupted - Second Shutdown Signal Received")
+	case <-l.ctx.Done():
+		slog.WarnContext(l.ctx, "Drain Interrupted - Context Cancelled")
+	}
+}
+
+// order sorts hooks in ascending order of [Hook.Priority], preserving the order of registration for equal priorities.
+func order(hooks []Hook) []Hook {
+	sort.SliceStable(hooks, func(i, j int) bool {
+		return hooks[i].Priority < hooks[j].Priority
+	})
+
+	return hooks
+}
+
+// evaluate calls the hook's function, abandoning it if its deadline is exceeded.
+func (h Hook) evaluate(ctx context.Context) error {
+	if h.Function == nil {
+		return nil
+	}
+
+	if h.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, h.Timeout)
+		defer cancel()
+	}
+
+	channel := make(chan error, 1)
+	go func() {
+		channel <- h.Function(ctx)
 	}()
+
+	select {
+	case e := <-channel:
+		if e != nil {
+			return fmt.Errorf("hook (%s): %w", h.Name, e)
+		}
+
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("hook (%s): %w", h.Name, ctx.Err())
+	}
 }
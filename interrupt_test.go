@@ -0,0 +1,259 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/x-ethr/server"
+	"github.com/x-ethr/server/health"
+)
+
+type stub struct{}
+
+func (stub) Shutdown(context.Context) error { return nil }
+
+func TestInterrupt(t *testing.T) {
+	// Subtest(s) without a Shutdown.Health registry drain the default registry.
+	health.Default.Reset()
+	t.Cleanup(health.Default.Reset)
+
+	t.Run("Drain", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		registry := health.New()
+
+		mux := server.New()
+		mux.Handle("GET /ready", registry.Handler(health.Readiness))
+
+		api := server.Server(ctx, mux, "0", func(s *server.Settings) {
+			s.Host = "127.0.0.1"
+		})
+
+		go api.ListenAndServe()
+
+		<-api.Listening()
+
+		lifecycle := server.Interrupt(ctx, cancel, api, func(s *server.Shutdown) {
+			s.Drain = 250 * time.Millisecond
+			s.Signals = nil
+			s.Health = registry
+		})
+
+		address := "http://" + api.Addr().String() + "/ready"
+
+		response, e := http.Get(address)
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			t.Errorf("Expected Ready Status Code, Received: %d", response.StatusCode)
+		}
+
+		lifecycle.Stop()
+
+		time.Sleep(50 * time.Millisecond)
+
+		response, e = http.Get(address)
+		if e != nil {
+			t.Fatalf("Expected Server to Serve While Draining: %v", e)
+		}
+
+		response.Body.Close()
+
+		if response.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("Expected Draining Status Code, Received: %d", response.StatusCode)
+		}
+
+		if !(response.Close) {
+			t.Errorf("Expected Connection: close Header While Draining")
+		}
+
+		if e := lifecycle.Wait(); e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if health.Default.Draining() {
+			t.Errorf("Expected Default Registry to Remain Unaffected")
+		}
+	})
+
+	t.Run("Hooks", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		lifecycle := server.Interrupt(ctx, cancel, stub{}, func(s *server.Shutdown) {
+			s.Signals = nil
+		})
+
+		var order []string
+		record := func(name string) func(context.Context) error {
+			return func(context.Context) error {
+				order = append(order, name)
+				return nil
+			}
+		}
+
+		lifecycle.Register(
+			server.Hook{Name: "telemetry", Priority: 100, Function: record("telemetry")},
+			server.Hook{Name: "database", Priority: 10, Function: record("database")},
+			server.Hook{Name: "workers", Priority: 10, Function: record("workers")},
+		)
+
+		lifecycle.Stop()
+
+		if e := lifecycle.Wait(); e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if len(order) != 3 || order[0] != "database" || order[1] != "workers" || order[2] != "telemetry" {
+			t.Errorf("Unexpected Hook Order: %v", order)
+		}
+
+		if ctx.Err() == nil {
+			t.Errorf("Expected Context Cancellation")
+		}
+	})
+
+	t.Run("Deadline", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		lifecycle := server.Interrupt(ctx, cancel, stub{}, func(s *server.Shutdown) {
+			s.Grace = time.Second
+			s.Signals = nil
+		})
+
+		release := make(chan struct{})
+		defer close(release)
+
+		lifecycle.Register(server.Hook{Name: "blocking", Timeout: 10 * time.Millisecond, Function: func(context.Context) error {
+			<-release // ignores its context
+
+			return nil
+		}}, server.Hook{Name: "failure", Priority: 1, Function: func(context.Context) error {
+			return errors.New("failure")
+		}})
+
+		lifecycle.Stop()
+
+		e := lifecycle.Wait()
+		if !(errors.Is(e, context.DeadlineExceeded)) {
+			t.Errorf("Expected Hook Deadline Error, Received: %v", e)
+		}
+
+		if errs, ok := e.(interface{ Unwrap() []error }); !ok || len(errs.Unwrap()) != 2 {
+			t.Errorf("Expected Both Hook Errors, Received: %v", e)
+		}
+	})
+	t.Run("Context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		lifecycle := server.Interrupt(ctx, cancel, stub{}, func(s *server.Shutdown) {
+			s.Signals = nil
+		})
+
+		// e.g. following a failed ListenAndServe, without a call to Stop.
+		cancel()
+
+		select {
+		case <-lifecycle.Done():
+		case <-time.After(time.Second):
+			t.Fatalf("Expected Context Cancellation to Initiate Shutdown")
+		}
+	})
+
+	t.Run("Drain-Interrupted", func(t *testing.T) {
+		// drain initiates a shutdown with a long drain period, returning once the lifecycle is draining.
+		drain := func(t *testing.T, trigger func(lifecycle *server.Lifecycle)) (*server.Lifecycle, context.CancelFunc) {
+			ctx, cancel := context.WithCancel(context.Background())
+
+			registry := health.New()
+
+			lifecycle := server.Interrupt(ctx, cancel, stub{}, func(s *server.Shutdown) {
+				s.Drain = time.Minute
+				s.Signals = []os.Signal{syscall.SIGUSR1}
+				s.Health = registry
+			})
+
+			trigger(lifecycle)
+
+			deadline := time.Now().Add(time.Second)
+			for !(registry.Draining()) {
+				if time.Now().After(deadline) {
+					t.Fatalf("Expected Lifecycle to Drain")
+				}
+
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			return lifecycle, cancel
+		}
+
+		// signal sends the process the lifecycle's shutdown signal.
+		signal := func(*server.Lifecycle) {
+			syscall.Kill(os.Getpid(), syscall.SIGUSR1)
+		}
+
+		t.Run("Second-Signal", func(t *testing.T) {
+			lifecycle, _ := drain(t, signal)
+
+			signal(lifecycle)
+
+			select {
+			case <-lifecycle.Done():
+			case <-time.After(time.Second):
+				t.Fatalf("Expected Second Signal to End the Drain")
+			}
+		})
+
+		t.Run("Context", func(t *testing.T) {
+			lifecycle, cancel := drain(t, (*server.Lifecycle).Stop)
+
+			cancel()
+
+			select {
+			case <-lifecycle.Done():
+			case <-time.After(time.Second):
+				t.Fatalf("Expected Context Cancellation to End the Drain")
+			}
+		})
+	})
+
+	t.Run("Reload", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		lifecycle := server.Interrupt(ctx, cancel, stub{}, func(s *server.Shutdown) {
+			s.Signals = nil
+			s.Reloads = nil
+		})
+
+		var reloads int
+		lifecycle.OnReload(server.Hook{Name: "flags", Function: func(context.Context) error {
+			reloads++
+			return nil
+		}}, server.Hook{Name: "limits", Function: func(context.Context) error {
+			return errors.New("invalid configuration")
+		}})
+
+		if e := lifecycle.Reload(ctx); e == nil {
+			t.Errorf("Expected Reload Error")
+		}
+
+		if reloads != 1 {
+			t.Errorf("Expected Reload Hook Evaluation(s) (1), Received: %d", reloads)
+		}
+
+		if ctx.Err() != nil {
+			t.Errorf("Unexpected Shutdown Following Reload")
+		}
+
+		lifecycle.Stop()
+		lifecycle.Wait()
+	})
+}
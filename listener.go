@@ -0,0 +1,148 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// listen establishes the [net.Listener] described by the [Listener] settings.
+func listen(settings *Listener, address string) (net.Listener, error) {
+	switch network := settings.Network; network {
+	case "", "tcp", "tcp4", "tcp6":
+		if network == "" {
+			network = "tcp"
+		}
+
+		return net.Listen(network, address)
+	case "unix":
+		return socket(settings.Path, settings.Permissions)
+	case "systemd":
+		return activation(settings.Name)
+	default:
+		return nil, fmt.Errorf("invalid listener network: %q", network)
+	}
+}
+
+// socket establishes a Unix domain socket listener at path, removing any stale socket file left by a previous process.
+// A socket file is only considered stale if connecting to it is refused; a live socket owned by another process is
+// never removed.
+func socket(path string, permissions os.FileMode) (net.Listener, error) {
+	if path == "" {
+		return nil, errors.New("unix listener requires a socket path")
+	}
+
+	if information, e := os.Lstat(path); e == nil && information.Mode()&fs.ModeSocket != 0 {
+		connection, e := net.Dial("unix", path)
+		switch {
+		case e == nil:
+			connection.Close()
+
+			return nil, fmt.Errorf("unix socket in use: %s", path)
+		case errors.Is(e, syscall.ECONNREFUSED):
+			if e := os.Remove(path); e != nil {
+				return nil, fmt.Errorf("unable to remove stale unix socket: %w", e)
+			}
+		}
+	}
+
+	listener, e := net.Listen("unix", path)
+	if e != nil {
+		return nil, e
+	}
+
+	if permissions != 0 {
+		if e := os.Chmod(path, permissions); e != nil {
+			listener.Close()
+
+			return nil, fmt.Errorf("unable to set unix socket permissions: %w", e)
+		}
+	}
+
+	return listener, nil
+}
+
+// sockets represents the systemd socket activation environment, read - and unset - upon the first call to [activated].
+type sockets struct {
+	total int
+	names []string
+}
+
+// activations caches the process's systemd socket activation environment; see [activated].
+var activations = struct {
+	mutex   sync.Mutex
+	sockets *sockets
+}{}
+
+// activated returns the systemd socket activation environment. Per sd_listen_fds(3) with unset_environment, the
+// "LISTEN_PID", "LISTEN_FDS" and "LISTEN_FDNAMES" variable(s) are unset upon reading, such that child process(es) - e.g.
+// an upgrade, see [Lifecycle.Upgrade] - don't attempt to claim descriptor(s) they don't own. The environment is rejected
+// if "LISTEN_PID" doesn't match the process.
+func activated() (*sockets, error) {
+	activations.mutex.Lock()
+	defer activations.mutex.Unlock()
+
+	if activations.sockets != nil {
+		return activations.sockets, nil
+	}
+
+	pid, names := os.Getenv("LISTEN_PID"), os.Getenv("LISTEN_FDNAMES")
+
+	total, e := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+
+	for _, key := range []string{"LISTEN_PID", "LISTEN_FDS", "LISTEN_FDNAMES"} {
+		os.Unsetenv(key)
+	}
+
+	if v, e := strconv.Atoi(pid); e != nil || v != os.Getpid() {
+		return nil, errors.New("no systemd sockets were passed to the process")
+	}
+
+	if e != nil || total <= 0 {
+		return nil, errors.New("no systemd sockets were passed to the process")
+	}
+
+	activations.sockets = &sockets{total: total, names: strings.Split(names, ":")}
+
+	return activations.sockets, nil
+}
+
+// activation returns the listener inherited via systemd socket activation. See sd_listen_fds(3).
+//
+//   - Inherited file descriptors start at 3 ("SD_LISTEN_FDS_START").
+//   - If name is non-empty, the file descriptor is selected by its position in "LISTEN_FDNAMES".
+//   - The activation environment is unset once read; see [activated].
+func activation(name string) (net.Listener, error) {
+	sockets, e := activated()
+	if e != nil {
+		return nil, e
+	}
+
+	index := 0
+	if name != "" {
+		index = -1
+		for position, candidate := range sockets.names {
+			if candidate == name && position < sockets.total {
+				index = position
+				break
+			}
+		}
+
+		if index < 0 {
+			return nil, fmt.Errorf("systemd socket not found: %q", name)
+		}
+	}
+
+	const start = 3
+
+	file := os.NewFile(uintptr(start+index), fmt.Sprintf("systemd-socket-%d", index))
+	defer file.Close() // net.FileListener duplicates the descriptor
+
+	return net.FileListener(file)
+}
@@ -0,0 +1,132 @@
+package logging
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// temporary represents the state of a temporary log-level change. See [Temporary].
+var temporary struct {
+	mutex    sync.Mutex
+	timer    *time.Timer
+	previous slog.Level
+	expires  time.Time
+}
+
+// Temporary sets the global log-level for the given duration, after which the level reverts to the level preceding the
+// first of any consecutive temporary change(s). A ttl of 0 sets the level permanently, cancelling any pending reversion.
+func Temporary(v slog.Level, ttl time.Duration) {
+	temporary.mutex.Lock()
+	defer temporary.mutex.Unlock()
+
+	if temporary.timer != nil {
+		temporary.timer.Stop()
+		temporary.timer = nil
+	} else {
+		temporary.previous = Current()
+	}
+
+	temporary.expires = time.Time{}
+
+	Level(v)
+	slog.SetLogLoggerLevel(v)
+
+	if ttl <= 0 {
+		return
+	}
+
+	previous := temporary.previous
+
+	temporary.expires = time.Now().Add(ttl)
+	temporary.timer = time.AfterFunc(ttl, func() {
+		temporary.mutex.Lock()
+		defer temporary.mutex.Unlock()
+
+		temporary.timer = nil
+		temporary.expires = time.Time{}
+
+		Level(previous)
+		slog.SetLogLoggerLevel(previous)
+
+		slog.Warn("Temporary Log Level Expired", slog.String("level", Name(previous)))
+	})
+}
+
+// Status represents the global log-level's state, as returned by [Levels].
+type Status struct {
+	Level    string     `json:"level"`              // Level represents the current log-level.
+	Previous string     `json:"previous,omitempty"` // Previous represents the level restored upon expiration, if temporary.
+	Expires  *time.Time `json:"expires,omitempty"`  // Expires represents the temporary level's expiration, if temporary.
+}
+
+// Request represents a log-level change, as accepted by [Levels].
+type Request struct {
+	Level string `json:"level"`         // Level represents the new log-level. See [Parse] for valid value(s).
+	TTL   string `json:"ttl,omitempty"` // TTL represents an optional [time.Duration] after which the level reverts, e.g. "15m".
+}
+
+func status() Status {
+	temporary.mutex.Lock()
+	defer temporary.mutex.Unlock()
+
+	v := Status{Level: Name(Current())}
+	if temporary.timer != nil {
+		expires := temporary.expires
+
+		v.Previous, v.Expires = Name(temporary.previous), &expires
+	}
+
+	return v
+}
+
+// Levels returns an administrative [http.Handler] that reads (GET) and changes (PUT) the global log-level. A PUT request's
+// body is a JSON [Request]; responses are a JSON [Status].
+//
+//   - The handler performs no authorization, and should only be exposed via an internal or protected server.
+func Levels() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+
+		switch r.Method {
+		case http.MethodGet, http.MethodHead:
+		case http.MethodPut:
+			var request Request
+			if e := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<10)).Decode(&request); e != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(map[string]string{"error": "invalid request body"})
+				return
+			}
+
+			level, e := Parse(request.Level)
+			if e != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(map[string]string{"error": e.Error()})
+				return
+			}
+
+			var ttl time.Duration
+			if request.TTL != "" {
+				if ttl, e = time.ParseDuration(request.TTL); e != nil || ttl < 0 {
+					w.WriteHeader(http.StatusBadRequest)
+					json.NewEncoder(w).Encode(map[string]string{"error": "invalid ttl: " + request.TTL})
+					return
+				}
+			}
+
+			Temporary(level, ttl)
+
+			slog.WarnContext(r.Context(), "Log Level Changed", slog.String("level", Name(level)), slog.Duration("ttl", ttl), slog.String("remote", r.RemoteAddr))
+		default:
+			w.Header().Set("Allow", "GET, HEAD, PUT")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			json.NewEncoder(w).Encode(map[string]string{"error": "method not allowed"})
+			return
+		}
+
+		json.NewEncoder(w).Encode(status())
+	})
+}
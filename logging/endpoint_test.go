@@ -0,0 +1,68 @@
+package logging_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/x-ethr/server/logging"
+)
+
+func TestLevels(t *testing.T) {
+	original := logging.Current()
+	defer logging.Temporary(original, 0)
+
+	handler := logging.Levels()
+
+	request := func(t *testing.T, method, body string) (logging.Status, int) {
+		t.Helper()
+
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, "/", strings.NewReader(body)))
+
+		var status logging.Status
+		json.NewDecoder(recorder.Body).Decode(&status)
+
+		return status, recorder.Code
+	}
+
+	t.Run("Permanent", func(t *testing.T) {
+		status, code := request(t, http.MethodPut, `{"level":"warn"}`)
+		if code != http.StatusOK || status.Level != "WARN" || status.Expires != nil {
+			t.Errorf("Unexpected Response: %d, %+v", code, status)
+		}
+
+		if status, _ := request(t, http.MethodGet, ""); status.Level != "WARN" || logging.Current() != logging.Warn {
+			t.Errorf("Level Not Applied: %+v", status)
+		}
+	})
+
+	t.Run("Temporary", func(t *testing.T) {
+		status, code := request(t, http.MethodPut, `{"level":"trace","ttl":"50ms"}`)
+		if code != http.StatusOK || status.Level != "TRACE" || status.Previous != "WARN" || status.Expires == nil {
+			t.Errorf("Unexpected Response: %d, %+v", code, status)
+		}
+
+		time.Sleep(150 * time.Millisecond)
+
+		if v := logging.Current(); v != logging.Warn {
+			t.Errorf("Expected Level Reversion (WARN), Received: %s", logging.Name(v))
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, body := range []string{`{"level":"verbose"}`, `{"level":"info","ttl":"soon"}`, `{`} {
+			if _, code := request(t, http.MethodPut, body); code != http.StatusBadRequest {
+				t.Errorf("Expected Bad Request (%s), Received: %d", body, code)
+			}
+		}
+
+		if _, code := request(t, http.MethodPost, `{"level":"info"}`); code != http.StatusMethodNotAllowed {
+			t.Errorf("Expected Method Not Allowed, Received: %d", code)
+		}
+	})
+}
@@ -33,17 +33,8 @@ func init() { // USER > LOG_LEVEL > CI > DEFAULT
 	}
 
 	if v := os.Getenv("LOG_LEVEL"); v != "" {
-		switch value := strings.ToUpper(v); value {
-		case "TRACE":
-			variable = Trace
-		case "DEBUG":
-			variable = Debug
-		case "INFO", "LOG", "INFORMATION":
-			variable = Info
-		case "WARN", "WARNING":
-			variable = Warn
-		case "ERROR", "EXCEPTION":
-			variable = Error
+		if value, e := Parse(v); e == nil {
+			variable = value
 		}
 	}
 
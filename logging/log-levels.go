@@ -1,7 +1,9 @@
 package logging
 
 import (
+	"fmt"
 	"log/slog"
+	"strings"
 	"sync/atomic"
 )
 
@@ -19,3 +21,40 @@ var l atomic.Value // default logging level
 func Level(v slog.Level) {
 	l.Store(v)
 }
+
+// Current returns the atomic, global log-level.
+func Current() slog.Level {
+	if v, ok := l.Load().(slog.Level); ok {
+		return v
+	}
+
+	return Info
+}
+
+// Parse returns the log-level matching v, case-insensitively. Valid values are "TRACE", "DEBUG", "INFO" ("LOG",
+// "INFORMATION"), "WARN" ("WARNING") and "ERROR" ("EXCEPTION").
+func Parse(v string) (slog.Level, error) {
+	switch strings.ToUpper(strings.TrimSpace(v)) {
+	case "TRACE":
+		return Trace, nil
+	case "DEBUG":
+		return Debug, nil
+	case "INFO", "LOG", "INFORMATION":
+		return Info, nil
+	case "WARN", "WARNING":
+		return Warn, nil
+	case "ERROR", "EXCEPTION":
+		return Error, nil
+	default:
+		return Info, fmt.Errorf("invalid log level: %q", v)
+	}
+}
+
+// Name returns the log-level's name, e.g. "TRACE" for [Trace].
+func Name(v slog.Level) string {
+	if v == Trace {
+		return "TRACE"
+	}
+
+	return v.String()
+}
@@ -0,0 +1,34 @@
+package metadata
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/x-ethr/server"
+	"github.com/x-ethr/server/types"
+)
+
+// Routes returns an opt-in [http.Handler] that lists every route registered with mux, including each route's method,
+// pattern, middleware chain, timeout and version.
+//
+//   - The handler is typically registered on an internal-only route (or an administrative server) as it exposes the
+//     service's complete route table.
+func Routes(mux *server.Mux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.Process(w, r, func(x *types.CTX) {
+			const name = "routes"
+
+			ctx := x.Request().Context()
+
+			ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(name).Start(ctx, name)
+
+			defer span.End()
+
+			x.Complete(&types.Response{Status: http.StatusOK, Payload: map[string]interface{}{"routes": mux.Routes()}})
+			return
+		})
+
+		return
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/x-ethr/server/middleware/envoy"
 	"github.com/x-ethr/server/middleware/name"
 	"github.com/x-ethr/server/middleware/path"
+	"github.com/x-ethr/server/middleware/peer"
+	"github.com/x-ethr/server/middleware/route"
 	"github.com/x-ethr/server/middleware/servername"
 	"github.com/x-ethr/server/middleware/state"
 	"github.com/x-ethr/server/middleware/telemetry"
@@ -50,6 +52,14 @@ func (*generic) State() state.Implementation {
 	return state.New()
 }
 
+func (*generic) Route() route.Implementation {
+	return route.New()
+}
+
+func (*generic) Peer() peer.Implementation {
+	return peer.New()
+}
+
 type Middleware interface {
 	Path() path.Implementation           // Path - See the [path] package for additional details.
 	Version() versioning.Implementation  // Version - See the [versioning] package for additional details.
@@ -60,6 +70,8 @@ type Middleware interface {
 	Envoy() envoy.Implementation         // Envoy - See the [envoy] package for additional details.
 	Tracer() tracing.Implementation      // Tracer - See the [tracing] package for additional details.
 	State() state.Implementation         // State - See the [state] package for additional details.
+	Route() route.Implementation         // Route - See the [route] package for additional details.
+	Peer() peer.Implementation           // Peer - See the [peer] package for additional details.
 }
 
 func New() Middleware {
@@ -3,4 +3,6 @@
 // for wrapping telemetry route handler(s).
 //
 //   - The Implementation must be added to the stack **before** the telemetry.Implementation middleware.
+//   - The context value is the request's raw path; for low-cardinality log(s), span(s) and metric(s), see the
+//     route package's matched pattern.
 package path
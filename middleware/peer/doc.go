@@ -0,0 +1,6 @@
+// Package peer provides middleware that stores a verified mutual-TLS client certificate's identity as a context value. The
+// Peer value mirrors the field(s) of Envoy's X-Forwarded-Client-Cert (XFCC) header, such that handlers behave the same
+// irrespective of whether TLS is terminated by Envoy or by the server itself.
+//
+//   - Only certificates verified during the TLS handshake are evaluated; see the server's TLS client settings.
+package peer
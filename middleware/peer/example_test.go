@@ -0,0 +1,22 @@
+package peer_test
+
+import (
+	"net/http"
+
+	"github.com/x-ethr/server/middleware"
+	"github.com/x-ethr/server/middleware/peer"
+)
+
+func Example() {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(middleware.New().Peer().Value(r.Context()).SPIFFE))
+	})
+
+	handler := middleware.New().Peer().Configuration(func(options *peer.Settings) {
+		options.Required = true
+	}).Middleware(mux)
+
+	http.ListenAndServeTLS(":8443", "tls.crt", "tls.key", handler)
+}
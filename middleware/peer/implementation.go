@@ -0,0 +1,94 @@
+package peer
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+
+	"github.com/x-ethr/text"
+
+	"github.com/x-ethr/server/internal/keystore"
+	"github.com/x-ethr/server/logging"
+)
+
+type generic struct {
+	keystore.Valuer[*Peer]
+
+	options *Settings
+}
+
+func (g *generic) Configuration(options ...Variadic) Implementation {
+	var o = settings()
+	for _, option := range options {
+		option(o)
+	}
+
+	g.options = o
+
+	return g
+}
+
+func (*generic) Value(ctx context.Context) *Peer {
+	if v, ok := ctx.Value(key).(*Peer); ok {
+		return v
+	}
+
+	return nil
+}
+
+func (g *generic) Middleware(next http.Handler) http.Handler {
+	var name = text.Title(key.String(), func(o *text.Options) {
+		o.Log = true
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		{
+			var value *Peer
+			if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+				value = identity(r.TLS.VerifiedChains[0][0])
+			}
+
+			if value == nil && g.options.Required {
+				slog.WarnContext(ctx, "Verified Client Certificate Required", slog.String("path", r.URL.Path), slog.String("method", r.Method))
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+
+			slog.Log(ctx, logging.Trace, "Middleware", slog.String("name", name), slog.Group("context", slog.String("key", string(key)), slog.Any("value", value)))
+
+			ctx = context.WithValue(ctx, key, value)
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// identity establishes a [Peer] from a verified leaf certificate.
+func identity(certificate *x509.Certificate) *Peer {
+	digest := sha256.Sum256(certificate.Raw)
+
+	value := &Peer{
+		Hash:    hex.EncodeToString(digest[:]),
+		Subject: certificate.Subject.String(),
+		DNS:     certificate.DNSNames,
+		Email:   certificate.EmailAddresses,
+	}
+
+	for _, uri := range certificate.URIs {
+		value.URI = append(value.URI, uri.String())
+		if uri.Scheme == "spiffe" && value.SPIFFE == "" {
+			value.SPIFFE = uri.String()
+		}
+	}
+
+	for _, ip := range certificate.IPAddresses {
+		value.IP = append(value.IP, ip.String())
+	}
+
+	return value
+}
@@ -0,0 +1,5 @@
+package peer
+
+import "github.com/x-ethr/server/internal/keystore"
+
+var key = keystore.Keys().Peer()
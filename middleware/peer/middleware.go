@@ -0,0 +1,29 @@
+package peer
+
+import (
+	"context"
+	"net/http"
+)
+
+// Peer represents a verified client certificate's identity, using the same field(s) as Envoy's XFCC header.
+type Peer struct {
+	Hash    string   `json:"hash"`             // Hash represents the hex-encoded SHA-256 digest of the client certificate.
+	Subject string   `json:"subject"`          // Subject represents the client certificate's distinguished name.
+	URI     []string `json:"uri,omitempty"`    // URI represents the client certificate's URI SAN(s).
+	DNS     []string `json:"dns,omitempty"`    // DNS represents the client certificate's DNS SAN(s).
+	Email   []string `json:"email,omitempty"`  // Email represents the client certificate's email SAN(s).
+	IP      []string `json:"ip,omitempty"`     // IP represents the client certificate's IP address SAN(s).
+	SPIFFE  string   `json:"spiffe,omitempty"` // SPIFFE represents the first "spiffe://" URI SAN, if any.
+}
+
+type Implementation interface {
+	Value(ctx context.Context) *Peer
+	Configuration(options ...Variadic) Implementation
+	Middleware(next http.Handler) http.Handler
+}
+
+func New() Implementation {
+	return &generic{
+		options: settings(),
+	}
+}
@@ -0,0 +1,14 @@
+package peer
+
+import "github.com/x-ethr/server/internal/keystore"
+
+type Settings struct {
+	// Required will respond with a 403 (Forbidden) status code if the request doesn't include a verified client certificate. Defaults to false.
+	Required bool `json:"required" yaml:"required"`
+}
+
+type Variadic keystore.Variadic[Settings]
+
+func settings() *Settings {
+	return &Settings{}
+}
@@ -0,0 +1,64 @@
+package peer_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/x-ethr/server/middleware"
+	"github.com/x-ethr/server/middleware/peer"
+)
+
+func Test(t *testing.T) {
+	spiffe, _ := url.Parse("spiffe://cluster.local/ns/default/sa/client")
+
+	certificate := &x509.Certificate{
+		Raw:      []byte("certificate"),
+		Subject:  pkix.Name{CommonName: "client", Organization: []string{"x-ethr"}},
+		DNSNames: []string{"client.default.svc.cluster.local"},
+		URIs:     []*url.URL{spiffe},
+	}
+
+	t.Run("Verified", func(t *testing.T) {
+		var value *peer.Peer
+		handler := middleware.New().Peer().Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			value = middleware.New().Peer().Value(r.Context())
+		}))
+
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{certificate}}}
+
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+
+		switch {
+		case value == nil:
+			t.Fatalf("Expected Peer Context Value")
+		case value.SPIFFE != spiffe.String():
+			t.Errorf("Unexpected SPIFFE ID: %s", value.SPIFFE)
+		case value.Subject != "CN=client,O=x-ethr":
+			t.Errorf("Unexpected Subject: %s", value.Subject)
+		case len(value.DNS) != 1 || len(value.URI) != 1 || len(value.Hash) != 64:
+			t.Errorf("Unexpected Peer: %+v", value)
+		}
+	})
+
+	t.Run("Required", func(t *testing.T) {
+		handler := middleware.New().Peer().Configuration(func(options *peer.Settings) {
+			options.Required = true
+		}).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{certificate}} // unverified
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		if recorder.Code != http.StatusForbidden {
+			t.Errorf("Expected Status (403), Received: %d", recorder.Code)
+		}
+	})
+}
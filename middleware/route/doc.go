@@ -0,0 +1,7 @@
+// Package route provides middleware for adding the matched multiplexer route to the request's context. The
+// server.Mux automatically applies the Implementation to every registered route unless its metadata option
+// is disabled.
+//
+// In addition to the context value, the Implementation annotates the active span and the otelhttp.Labeler with the
+// route's low-cardinality "http.route" attribute, such that telemetry isn't keyed by the request's raw path.
+package route
@@ -0,0 +1,20 @@
+package route_test
+
+import (
+	"net/http"
+
+	"github.com/x-ethr/server/middleware"
+	"github.com/x-ethr/server/middleware/route"
+)
+
+func Example() {
+	mux := http.NewServeMux()
+
+	mux.Handle("GET /v1/users/{id}", middleware.New().Route().Configuration(func(options *route.Settings) {
+		options.Route = route.Route{Pattern: "GET /v1/users/{id}", Method: http.MethodGet, Path: "/v1/users/{id}"}
+	}).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(middleware.New().Route().Value(r.Context()).Pattern))
+	})))
+
+	http.ListenAndServe(":8080", mux)
+}
@@ -0,0 +1,76 @@
+package route
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/x-ethr/text"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/x-ethr/server/internal/keystore"
+	"github.com/x-ethr/server/logging"
+)
+
+type generic struct {
+	keystore.Valuer[*Route]
+
+	options *Settings
+}
+
+func (g *generic) Configuration(options ...Variadic) Implementation {
+	var o = settings()
+	for _, option := range options {
+		option(o)
+	}
+
+	g.options = o
+
+	return g
+}
+
+func (*generic) Value(ctx context.Context) *Route {
+	if v, ok := ctx.Value(key).(*Route); ok {
+		return v
+	}
+
+	return nil
+}
+
+func (g *generic) Middleware(next http.Handler) http.Handler {
+	var name = text.Title(key.String(), func(o *text.Options) {
+		o.Log = true
+	})
+
+	// attribute represents the low-cardinality [semconv.HTTPRoute] used by both span(s) and metric(s) in place of the request's path.
+	attribute := semconv.HTTPRoute(g.options.Route.Path)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		{
+			// value is a per-request copy; a handler mutating its route can't affect the configuration, or concurrent request(s).
+			value := g.options.Route
+			value.Middleware = slices.Clone(value.Middleware)
+
+			slog.Log(ctx, logging.Trace, "Middleware", slog.String("name", name), slog.Group("context", slog.String("key", string(key)), slog.String("value", value.Pattern)))
+
+			ctx = context.WithValue(ctx, key, &value)
+
+			if span := trace.SpanFromContext(ctx); span.IsRecording() {
+				span.SetAttributes(attribute)
+				span.SetName(strings.TrimSpace(r.Method + " " + value.Path))
+			}
+
+			if labeler, ok := otelhttp.LabelerFromContext(ctx); ok {
+				labeler.Add(attribute)
+			}
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,5 @@
+package route
+
+import "github.com/x-ethr/server/internal/keystore"
+
+var key = keystore.Keys().Route()
@@ -0,0 +1,45 @@
+package route
+
+import (
+	"context"
+	"net/http"
+)
+
+// Route represents a registered multiplexer route.
+type Route struct {
+	// Name represents the route's optional, user-defined name.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Pattern represents the full [http.ServeMux] pattern the route was registered with (e.g. "GET /v1/users/{id}").
+	Pattern string `json:"pattern" yaml:"pattern"`
+
+	// Method represents the pattern's HTTP method. An empty value matches all methods.
+	Method string `json:"method,omitempty" yaml:"method,omitempty"`
+
+	// Host represents the pattern's optional host.
+	Host string `json:"host,omitempty" yaml:"host,omitempty"`
+
+	// Path represents the pattern's path, including any wildcard(s).
+	Path string `json:"path" yaml:"path"`
+
+	// Middleware represents the name(s) of the middleware(s) wrapping the route, in order of execution.
+	Middleware []string `json:"middleware" yaml:"middleware"`
+
+	// Timeout represents the route's effective timeout, if established by the timeout middleware.
+	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	// Version represents the route's service version, if established by the versioning middleware.
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+}
+
+type Implementation interface {
+	Value(ctx context.Context) *Route
+	Configuration(options ...Variadic) Implementation
+	Middleware(next http.Handler) http.Handler
+}
+
+func New() Implementation {
+	return &generic{
+		options: settings(),
+	}
+}
@@ -0,0 +1,14 @@
+package route
+
+import "github.com/x-ethr/server/internal/keystore"
+
+type Settings struct {
+	// Route represents the registered route to add as a context value.
+	Route Route `json:"route" yaml:"route"`
+}
+
+type Variadic keystore.Variadic[Settings]
+
+func settings() *Settings {
+	return &Settings{}
+}
@@ -44,7 +44,7 @@ func (g *generic) Middleware(next http.Handler) http.Handler {
 		g.options.Timeout = (time.Second * 30)
 	}
 
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	return handler{timeout: g.options.Timeout, HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
 		{
@@ -68,5 +68,17 @@ func (g *generic) Middleware(next http.Handler) http.Handler {
 		}()
 
 		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	}}
+}
+
+// handler represents the middleware's [http.Handler], exposing the configured timeout for route introspection.
+type handler struct {
+	http.HandlerFunc
+
+	timeout time.Duration
+}
+
+// Timeout returns the middleware's configured timeout.
+func (h handler) Timeout() time.Duration {
+	return h.timeout
 }
@@ -41,7 +41,7 @@ func (g *generic) Middleware(next http.Handler) http.Handler {
 		o.Log = true
 	})
 
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	return handler{version: g.options.Version, HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
 		{
@@ -60,5 +60,17 @@ func (g *generic) Middleware(next http.Handler) http.Handler {
 		}
 
 		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	}}
+}
+
+// handler represents the middleware's [http.Handler], exposing the configured version for route introspection.
+type handler struct {
+	http.HandlerFunc
+
+	version Version
+}
+
+// Version returns the middleware's configured version.
+func (h handler) Version() Version {
+	return h.version
 }
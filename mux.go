@@ -0,0 +1,403 @@
+package server
+
+import (
+	"net/http"
+	"path"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/x-ethr/server/middleware/route"
+	"github.com/x-ethr/server/middleware/versioning"
+)
+
+// Mux is an [http.Handler] multiplexer built on top of Go 1.22 [http.ServeMux] pattern(s). In addition to routing,
+// the Mux composes the global [Options.Globals] middleware(s) with route-specific [Options.Middleware].
+//
+//   - Middleware(s) are composed lazily, during the first request to a given route, and recomposed following any
+//     subsequent call to [Mux.Middleware] or [Group.Middleware].
+type Mux struct {
+	mux *http.ServeMux
+
+	options *Options
+
+	mutex  sync.Mutex
+	routes []*entry
+
+	revision atomic.Uint64 // revision is incremented upon every change to the global or group middleware(s).
+	fallback composite     // fallback represents the composed handler for unmatched request(s); see [Mux.unmatched].
+}
+
+// entry represents a registered route, and its lazily-composed [http.Handler].
+type entry struct {
+	parent *Mux
+	group  *Group
+
+	route   route.Route
+	options *Options
+
+	handler http.Handler
+
+	chain composite
+}
+
+// composite represents a lazily-composed middleware chain, recomposed whenever the [Mux] revision changes.
+type composite struct {
+	mutex   sync.Mutex
+	current atomic.Pointer[composition]
+}
+
+// composition represents a composed middleware chain, and the [Mux] revision it was composed against.
+type composition struct {
+	revision uint64
+	handler  http.Handler
+	route    route.Route
+}
+
+// load returns the composition for the given revision, composing it if necessary.
+func (c *composite) load(revision uint64, compose func() (http.Handler, route.Route)) *composition {
+	if current := c.current.Load(); current != nil && current.revision == revision {
+		return current
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if current := c.current.Load(); current != nil && current.revision == revision {
+		return current
+	}
+
+	handler, value := compose()
+
+	current := &composition{revision: revision, handler: handler, route: value}
+
+	c.current.Store(current)
+
+	return current
+}
+
+// New constructs a [Mux] using the optional [Variadic] settings. See [Options] for default(s).
+func New(settings ...Variadic) *Mux {
+	var o = options()
+	for _, option := range settings {
+		option(o)
+	}
+
+	m := &Mux{
+		mux:     http.NewServeMux(),
+		options: o,
+		routes:  make([]*entry, 0),
+	}
+
+	if m.options.NotFound == nil {
+		m.options.NotFound = m.response(http.StatusNotFound)
+	}
+
+	if m.options.MethodNotAllowed == nil {
+		m.options.MethodNotAllowed = m.response(http.StatusMethodNotAllowed)
+	}
+
+	return m
+}
+
+// Middleware adds global middleware(s) to the [Mux]. Global middleware(s) wrap every registered route, unless the route
+// disables them via [Globals.Disable].
+func (m *Mux) Middleware(middlewares ...func(http.Handler) http.Handler) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.options.Globals.Middleware = append(m.options.Globals.Middleware, middlewares...)
+
+	m.revision.Add(1)
+}
+
+// Register adds a [http.HandlerFunc] to the [Mux] using the [http.ServeMux] pattern syntax, e.g. "GET /v1/users/{id}".
+//
+//   - For route-level [Options], only [Globals.Disable], [Options.Metadata], [Options.Name], [Options.Middleware] and [Options.Strip] (see [Mux.Mount]) are evaluated.
+//   - Register panics under the same conditions as [http.ServeMux.Handle] (i.e. an invalid or conflicting pattern).
+func (m *Mux) Register(pattern string, handler http.HandlerFunc, settings ...Variadic) {
+	m.Handle(pattern, handler, settings...)
+}
+
+// Handle is the [http.Handler] equivalent of [Mux.Register].
+func (m *Mux) Handle(pattern string, handler http.Handler, settings ...Variadic) {
+	m.register(nil, pattern, handler, settings...)
+}
+
+// Group establishes a [Group] of routes that share the given path prefix, and any middleware(s) added via [Group.Middleware].
+//
+//   - The prefix is joined with every pattern registered through the group, e.g. a group of "/v1/service" and a pattern of
+//     "GET /users/{id}" registers "GET /v1/service/users/{id}".
+func (m *Mux) Group(prefix string, fn func(g *Group)) {
+	fn(&Group{mux: m, prefix: clean(prefix), middleware: make([]func(http.Handler) http.Handler, 0)})
+}
+
+// Mount delegates all requests, irrespective of method, under the given path prefix to handler. Typically, the handler is
+// another [Mux] acting as a sub-router.
+//
+//   - See [Options.Strip] for configuring whether the prefix is removed from the request's path prior to calling handler.
+//   - The [Mux] global middleware(s) wrap the mounted handler in addition to any of the handler's own middleware(s).
+func (m *Mux) Mount(prefix string, handler http.Handler, settings ...Variadic) {
+	m.mount(nil, prefix, handler, settings...)
+}
+
+func (m *Mux) mount(group *Group, prefix string, handler http.Handler, settings ...Variadic) {
+	var o = options()
+	for _, option := range settings {
+		option(o)
+	}
+
+	path := clean(prefix)
+	if full := path; o.Strip {
+		if group != nil {
+			full = join(group.prefix, path)
+		}
+
+		handler = http.StripPrefix(strings.TrimSuffix(full, "/"), handler)
+	}
+
+	m.register(group, strings.TrimSuffix(path, "/")+"/", handler, settings...)
+}
+
+func (m *Mux) register(group *Group, pattern string, handler http.Handler, settings ...Variadic) {
+	var o = options()
+
+	o.Metadata = m.options.Metadata
+	for _, option := range settings {
+		option(o)
+	}
+
+	method, host, path := parse(pattern)
+	if group != nil {
+		path = join(group.prefix, path)
+
+		pattern = host + path
+		if method != "" {
+			pattern = method + " " + pattern
+		}
+	}
+
+	instance := &entry{
+		parent:  m,
+		group:   group,
+		route:   route.Route{Name: o.Name, Pattern: pattern, Method: method, Host: host, Path: path},
+		options: o,
+		handler: handler,
+	}
+
+	m.mux.Handle(pattern, instance)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.routes = append(m.routes, instance)
+}
+
+// ServeHTTP dispatches the request to the handler whose pattern most closely matches the request. Unmatched request(s)
+// are wrapped with the global middleware(s) prior to evaluating [Options.NotFound], [Options.MethodNotAllowed], or an
+// automatic OPTIONS response.
+//
+//   - HEAD request(s) are matched by GET route(s), per [http.ServeMux].
+//   - Matched request(s) are served by the underlying [http.ServeMux], which establishes [http.Request.Pattern] and the
+//     path wildcard(s) (see [http.Request.PathValue]), and redirects unclean path(s), e.g. "/a//b" to "/a/b".
+func (m *Mux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if _, pattern := m.mux.Handler(r); pattern != "" || !(canonical(r)) {
+		m.mux.ServeHTTP(w, r)
+		return
+	}
+
+	fallback := m.fallback.load(m.revision.Load(), func() (http.Handler, route.Route) {
+		middlewares := Middleware()
+		middlewares.Add(m.globals()...)
+
+		return middlewares.Handler(http.HandlerFunc(m.unmatched)), route.Route{}
+	})
+
+	fallback.handler.ServeHTTP(w, r)
+}
+
+// Routes returns a description of every registered route, in order of registration. Calling Routes composes any route
+// middleware chain(s) that haven't yet served a request, or that have changed since.
+func (m *Mux) Routes() []route.Route {
+	m.mutex.Lock()
+	entries := append(make([]*entry, 0, len(m.routes)), m.routes...)
+	m.mutex.Unlock()
+
+	routes := make([]route.Route, 0, len(entries))
+	for index := range entries {
+		routes = append(routes, entries[index].build().route)
+	}
+
+	return routes
+}
+
+// globals returns a copy of the [Mux] global middleware(s).
+func (m *Mux) globals() []func(http.Handler) http.Handler {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return append(make([]func(http.Handler) http.Handler, 0, len(m.options.Globals.Middleware)), m.options.Globals.Middleware...)
+}
+
+// compose establishes the route's middleware chain: route metadata -> global middleware(s) -> group middleware(s) -> route middleware(s) -> handler.
+// The returned [route.Route] describes the composed chain.
+func (e *entry) compose() (http.Handler, route.Route) {
+	value := e.route
+
+	chain := make([]func(http.Handler) http.Handler, 0)
+	if !(e.options.Globals.Disable) {
+		chain = append(chain, e.parent.globals()...)
+	}
+
+	if e.group != nil {
+		chain = append(chain, e.group.chain()...)
+	}
+
+	chain = append(chain, e.options.Middleware...)
+
+	value.Middleware = make([]string, len(chain))
+
+	var handler = e.handler
+	var timeout time.Duration
+	for index := len(chain) - 1; index >= 0; index-- {
+		handler = chain[index](handler)
+
+		value.Middleware[index] = identify(chain[index], handler)
+
+		probe := handler
+		if v, ok := handler.(named); ok {
+			probe = v.Handler
+		}
+
+		switch v := probe.(type) {
+		case interface{ Timeout() time.Duration }:
+			if timeout <= 0 || v.Timeout() < timeout {
+				timeout = v.Timeout()
+			}
+		case interface{ Version() versioning.Version }:
+			if value.Version == "" {
+				value.Version = v.Version().Service
+			}
+		}
+	}
+
+	if timeout > 0 {
+		value.Timeout = timeout.String()
+	}
+
+	if e.options.Metadata {
+		metadata := value
+
+		handler = route.New().Configuration(func(options *route.Settings) {
+			options.Route = metadata
+		}).Middleware(handler)
+	}
+
+	return handler, value
+}
+
+// build returns the route's middleware chain, composing it if it hasn't been composed against the current [Mux] revision.
+func (e *entry) build() *composition {
+	return e.chain.load(e.parent.revision.Load(), e.compose)
+}
+
+func (e *entry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.build().handler.ServeHTTP(w, r)
+}
+
+// canonical reports whether the request's path is clean, i.e. whether [http.ServeMux] would serve the request rather
+// than redirect it to the cleaned path. CONNECT request(s) aren't canonicalized.
+func canonical(r *http.Request) bool {
+	if r.Method == http.MethodConnect {
+		return true
+	}
+
+	value := r.URL.EscapedPath()
+	if value == "" || value[0] != '/' {
+		return false
+	}
+
+	cleaned := path.Clean(value)
+	if strings.HasSuffix(value, "/") && cleaned != "/" {
+		cleaned += "/"
+	}
+
+	return cleaned == value
+}
+
+// parse splits a [http.ServeMux] pattern into its method, host and path component(s).
+func parse(pattern string) (method, host, path string) {
+	path = strings.TrimSpace(pattern)
+	if index := strings.IndexAny(path, " \t"); index >= 0 {
+		method, path = path[:index], strings.TrimLeft(path[index+1:], " \t")
+	}
+
+	if index := strings.Index(path, "/"); index > 0 {
+		host, path = path[:index], path[index:]
+	}
+
+	return
+}
+
+// Named wraps middleware, naming it in the route's metadata (see [route.Route.Middleware]) - e.g. for closure(s), whose
+// derived name(s) are otherwise compiler-generated (see [identify]).
+func Named(name string, middleware func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return named{Handler: middleware(next), name: name}
+	}
+}
+
+// named represents a [Named] middleware's handler.
+type named struct {
+	http.Handler
+
+	name string
+}
+
+func (n named) Name() string {
+	return n.name
+}
+
+// identify returns a middleware's human-readable name. A handler - returned by the middleware - implementing
+// interface{ Name() string } reports its own name; see [Named].
+//
+//   - Otherwise, the name is derived from the middleware function's symbol (see [runtime.FuncForPC]), e.g. "timeout"
+//     for the [timeout] package's middleware. Derived name(s) depend on the compiler, and are a best-effort fallback.
+func identify(middleware func(http.Handler) http.Handler, handler http.Handler) string {
+	if v, ok := handler.(interface{ Name() string }); ok && v.Name() != "" {
+		return v.Name()
+	}
+
+	function := runtime.FuncForPC(reflect.ValueOf(middleware).Pointer())
+	if function == nil {
+		return "unknown"
+	}
+
+	name := strings.TrimSuffix(function.Name(), "-fm")
+	if index := strings.LastIndex(name, "/"); index >= 0 {
+		name = name[index+1:]
+	}
+
+	for _, suffix := range []string{".Implementation.Middleware", ".(*generic).Middleware", ".generic.Middleware"} {
+		name = strings.TrimSuffix(name, suffix)
+	}
+
+	return name
+}
+
+// clean ensures a path prefix is rooted.
+func clean(prefix string) string {
+	if !(strings.HasPrefix(prefix, "/")) {
+		prefix = "/" + prefix
+	}
+
+	return prefix
+}
+
+// join appends a pattern's path onto a rooted prefix.
+func join(prefix, path string) string {
+	return strings.TrimSuffix(prefix, "/") + path
+}
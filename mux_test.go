@@ -0,0 +1,431 @@
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/x-ethr/server"
+	"github.com/x-ethr/server/middleware"
+	"github.com/x-ethr/server/middleware/route"
+	"github.com/x-ethr/server/middleware/timeout"
+	"github.com/x-ethr/server/middleware/versioning"
+)
+
+func header(key, value string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add(key, value)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func TestMux(t *testing.T) {
+	t.Run("Middleware-Order", func(t *testing.T) {
+		mux := server.New()
+
+		mux.Middleware(header("X-Order", "global"))
+		mux.Register("GET /ordering", func(w http.ResponseWriter, r *http.Request) {}, func(o *server.Options) {
+			o.Middleware = append(o.Middleware, header("X-Order", "route"))
+		})
+
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/ordering", nil))
+
+		if values := recorder.Header().Values("X-Order"); len(values) != 2 || values[0] != "global" || values[1] != "route" {
+			t.Errorf("Unexpected Middleware Order, Received: %v", values)
+		}
+	})
+
+	t.Run("Globals-Disable", func(t *testing.T) {
+		mux := server.New()
+
+		mux.Middleware(header("X-Global", "true"))
+		mux.Register("GET /disabled", func(w http.ResponseWriter, r *http.Request) {}, func(o *server.Options) {
+			o.Globals.Disable = true
+		})
+
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/disabled", nil))
+
+		if v := recorder.Header().Get("X-Global"); v != "" {
+			t.Errorf("Global Middleware Should be Disabled, Received: %s", v)
+		}
+	})
+
+	t.Run("Metadata-Route", func(t *testing.T) {
+		mux := server.New()
+
+		mux.Middleware(middleware.New().Path().Middleware)
+
+		var value *route.Route
+		mux.Register("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+			value = middleware.New().Route().Value(r.Context())
+		}, func(o *server.Options) {
+			o.Name = "user"
+		})
+
+		mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/1", nil))
+
+		switch {
+		case value == nil:
+			t.Fatalf("Expected Route Context Value")
+		case value.Name != "user" || value.Method != http.MethodGet || value.Path != "/users/{id}":
+			t.Errorf("Unexpected Route Context Value: %+v", value)
+		case len(value.Middleware) != 1 || value.Middleware[0] != "path":
+			t.Errorf("Unexpected Route Middleware Name(s): %v", value.Middleware)
+		}
+	})
+
+	t.Run("Metadata-Route-Isolation", func(t *testing.T) {
+		mux := server.New()
+
+		mux.Middleware(middleware.New().Path().Middleware)
+
+		var values []*route.Route
+		var observed []string
+		mux.Register("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+			value := middleware.New().Route().Value(r.Context())
+
+			values = append(values, value)
+			observed = append(observed, value.Name+", "+value.Middleware[0])
+
+			// Mutation(s) are local to the request.
+			value.Name = "mutated"
+			value.Middleware[0] = "mutated"
+		}, func(o *server.Options) {
+			o.Name = "user"
+		})
+
+		for range 2 {
+			mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/1", nil))
+		}
+
+		switch {
+		case len(values) != 2:
+			t.Fatalf("Expected (2) Route Context Values, Received: %d", len(values))
+		case values[0] == values[1]:
+			t.Errorf("Expected a Distinct Route Context Value per Request")
+		case observed[0] != "user, path" || observed[1] != "user, path":
+			t.Errorf("Route Context Value Mutated Across Request(s): %v", observed)
+		}
+
+		if routes := mux.Routes(); len(routes) != 1 || routes[0].Name != "user" || routes[0].Middleware[0] != "path" {
+			t.Errorf("Route Configuration Mutated by Handler: %+v", routes)
+		}
+	})
+
+	t.Run("Metadata", func(t *testing.T) {
+		for _, enabled := range []bool{true, false} {
+			mux := server.New(func(o *server.Options) { o.Metadata = enabled })
+
+			var pattern string
+			mux.Register("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+				if v := middleware.New().Route().Value(r.Context()); v != nil {
+					pattern = v.Pattern
+				}
+			})
+
+			mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/1", nil))
+
+			if enabled && pattern != "GET /users/{id}" {
+				t.Errorf("Expected Route Pattern (GET /users/{id}), Received: %q", pattern)
+			} else if !(enabled) && pattern != "" {
+				t.Errorf("Expected Disabled Route Metadata, Received: %q", pattern)
+			}
+		}
+	})
+}
+
+func TestRoutes(t *testing.T) {
+	mux := server.New()
+
+	mux.Middleware(middleware.New().Timeout().Configuration(func(options *timeout.Settings) { options.Timeout = time.Minute }).Middleware)
+	mux.Middleware(middleware.New().Version().Configuration(func(options *versioning.Settings) { options.Version.Service = "1.0.0" }).Middleware)
+
+	mux.Register("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {}, func(o *server.Options) {
+		o.Middleware = append(o.Middleware, middleware.New().Timeout().Configuration(func(options *timeout.Settings) { options.Timeout = time.Second }).Middleware)
+	})
+
+	mux.Register("GET /health", func(w http.ResponseWriter, r *http.Request) {}, func(o *server.Options) {
+		o.Globals.Disable = true
+	})
+
+	routes := mux.Routes()
+	if len(routes) != 2 {
+		t.Fatalf("Expected (2) Routes, Received: %d", len(routes))
+	}
+
+	if v := routes[0]; v.Timeout != "1s" || v.Version != "1.0.0" || len(v.Middleware) != 3 {
+		t.Errorf("Unexpected Route Description: %+v", v)
+	}
+
+	if v := routes[1]; v.Timeout != "" || v.Version != "" || len(v.Middleware) != 0 {
+		t.Errorf("Unexpected Route Description: %+v", v)
+	}
+}
+
+// labelled represents a middleware handler reporting its own name.
+type labelled struct {
+	http.Handler
+}
+
+func (labelled) Name() string {
+	return "labelled"
+}
+
+func TestMiddlewareNames(t *testing.T) {
+	mux := server.New()
+
+	mux.Middleware(server.Named("header", header("X-Named", "global")))
+	mux.Middleware(func(next http.Handler) http.Handler { return labelled{next} })
+
+	mux.Register("GET /names", func(w http.ResponseWriter, r *http.Request) {}, func(o *server.Options) {
+		o.Middleware = append(o.Middleware, server.Named("deadline", middleware.New().Timeout().Configuration(func(options *timeout.Settings) { options.Timeout = time.Second }).Middleware))
+		o.Middleware = append(o.Middleware, header("X-Named", "route"))
+	})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/names", nil))
+
+	if values := recorder.Header().Values("X-Named"); len(values) != 2 {
+		t.Errorf("Expected Named Middleware to Apply, Received: %v", values)
+	}
+
+	routes := mux.Routes()
+	if len(routes) != 1 {
+		t.Fatalf("Expected (1) Route, Received: %d", len(routes))
+	}
+
+	names := routes[0].Middleware
+	switch {
+	case len(names) != 4:
+		t.Fatalf("Unexpected Middleware Name(s): %v", names)
+	case names[0] != "header" || names[1] != "labelled" || names[2] != "deadline":
+		t.Errorf("Expected Reported Middleware Name(s), Received: %v", names)
+	case names[3] == "" || names[3] == "unknown":
+		t.Errorf("Expected Derived Fallback Middleware Name, Received: %q", names[3])
+	}
+
+	if v := routes[0].Timeout; v != "1s" {
+		t.Errorf("Expected Named Middleware's Timeout (1s), Received: %q", v)
+	}
+}
+
+func TestWildcards(t *testing.T) {
+	mux := server.New()
+
+	mux.Register("GET /teams/{team}/files/{path...}", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.PathValue("team") + ":" + r.PathValue("path")))
+	})
+
+	recorder := httptest.NewRecorder()
+
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/teams/a%20b/files/x/y.txt", nil))
+
+	if body := recorder.Body.String(); body != "a b:x/y.txt" {
+		t.Errorf("Unexpected Path Value(s): %s", body)
+	}
+}
+
+func TestPattern(t *testing.T) {
+	mux := server.New()
+
+	// The request's Pattern field (Go 1.23+) is read via reflection, as the module targets Go 1.22.
+	mux.Register("GET /a/{x}/b", func(w http.ResponseWriter, r *http.Request) {
+		if field := reflect.ValueOf(r).Elem().FieldByName("Pattern"); field.IsValid() {
+			w.Write([]byte(field.String()))
+		}
+	})
+
+	t.Run("Matched", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/a/1/b", nil))
+
+		if body := recorder.Body.String(); body != "GET /a/{x}/b" {
+			t.Errorf("Unexpected Request Pattern: %q", body)
+		}
+	})
+
+	t.Run("Unclean", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/a//1/b", nil))
+
+		if recorder.Code != http.StatusTemporaryRedirect || recorder.Header().Get("Location") != "/a/1/b" {
+			t.Errorf("Expected Redirect to Cleaned Path, Received (%d): %s", recorder.Code, recorder.Header().Get("Location"))
+		}
+	})
+}
+
+func TestRecomposition(t *testing.T) {
+	mux := server.New()
+
+	mux.Register("GET /users", func(w http.ResponseWriter, r *http.Request) {})
+
+	if routes := mux.Routes(); len(routes[0].Middleware) != 0 {
+		t.Fatalf("Unexpected Route Middleware: %v", routes[0].Middleware)
+	}
+
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users", nil))
+
+	mux.Middleware(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Late", "true")
+
+			next.ServeHTTP(w, r)
+		})
+	})
+
+	if routes := mux.Routes(); len(routes[0].Middleware) != 1 {
+		t.Errorf("Expected (1) Route Middleware, Received: %v", routes[0].Middleware)
+	}
+
+	for _, target := range []string{"/users", "/unknown"} {
+		recorder := httptest.NewRecorder()
+
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+
+		if recorder.Header().Get("X-Late") != "true" {
+			t.Errorf("Expected Late Middleware to Wrap (%s)", target)
+		}
+	}
+}
+
+func TestGroup(t *testing.T) {
+	t.Run("Prefix-And-Middleware", func(t *testing.T) {
+		mux := server.New()
+
+		mux.Middleware(header("X-Order", "global"))
+		mux.Group("/v1/service", func(g *server.Group) {
+			g.Middleware(header("X-Order", "group"))
+
+			g.Group("/users", func(g *server.Group) {
+				g.Middleware(header("X-Order", "nested"))
+
+				g.Register("GET /{id}", func(w http.ResponseWriter, r *http.Request) {
+					w.Write([]byte(r.PathValue("id")))
+				})
+			})
+		})
+
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/service/users/1", nil))
+
+		if recorder.Code != http.StatusOK || recorder.Body.String() != "1" {
+			t.Fatalf("Unexpected Response (%d): %s", recorder.Code, recorder.Body.String())
+		}
+
+		if values := recorder.Header().Values("X-Order"); len(values) != 3 || values[0] != "global" || values[1] != "group" || values[2] != "nested" {
+			t.Errorf("Unexpected Middleware Order, Received: %v", values)
+		}
+	})
+
+	t.Run("Mount", func(t *testing.T) {
+		for _, strip := range []bool{true, false} {
+			sub := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(r.URL.Path))
+			})
+
+			mux := server.New()
+			mux.Group("/v1", func(g *server.Group) {
+				g.Mount("/admin", sub, func(o *server.Options) { o.Strip = strip })
+			})
+
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/v1/admin/users", nil))
+
+			expectation := "/users"
+			if !(strip) {
+				expectation = "/v1/admin/users"
+			}
+
+			if v := recorder.Body.String(); v != expectation {
+				t.Errorf("Expected Mounted Path (%s), Received: %s", expectation, v)
+			}
+		}
+	})
+}
+
+func TestUnmatched(t *testing.T) {
+	mux := server.New()
+
+	mux.Middleware(header("X-Global", "true"))
+	mux.Register("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.PathValue("id")))
+	})
+	mux.Register("DELETE /users/{id}", func(w http.ResponseWriter, r *http.Request) {})
+
+	t.Run("Not-Found", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/unknown", nil))
+
+		if recorder.Code != http.StatusNotFound || recorder.Header().Get("Content-Type") != "application/json" {
+			t.Errorf("Unexpected Response (%d): %s", recorder.Code, recorder.Header().Get("Content-Type"))
+		}
+
+		if recorder.Header().Get("X-Global") != "true" {
+			t.Errorf("Expected Global Middleware to Wrap Unmatched Request")
+		}
+	})
+
+	t.Run("Method-Not-Allowed", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/users/1", nil))
+
+		if recorder.Code != http.StatusMethodNotAllowed {
+			t.Errorf("Expected Status (405), Received: %d", recorder.Code)
+		}
+
+		if v := recorder.Header().Get("Allow"); v != "GET, HEAD, DELETE, OPTIONS" {
+			t.Errorf("Unexpected Allow Header: %s", v)
+		}
+	})
+
+	t.Run("Custom-Method", func(t *testing.T) {
+		mux := server.New()
+
+		mux.Register("TRACE /custom", func(w http.ResponseWriter, r *http.Request) {})
+		mux.Register("GET /other", func(w http.ResponseWriter, r *http.Request) {})
+
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/custom", nil))
+
+		if v := recorder.Header().Get("Allow"); recorder.Code != http.StatusMethodNotAllowed || v != "TRACE, OPTIONS" {
+			t.Errorf("Unexpected Response (%d), Allow: %s", recorder.Code, v)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodOptions, "/users/1", nil))
+
+		if recorder.Code != http.StatusNoContent || recorder.Header().Get("Allow") == "" {
+			t.Errorf("Unexpected Response (%d), Allow: %s", recorder.Code, recorder.Header().Get("Allow"))
+		}
+	})
+
+	t.Run("Head", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodHead, "/users/1", nil))
+
+		if recorder.Code != http.StatusOK {
+			t.Errorf("Expected Status (200), Received: %d", recorder.Code)
+		}
+	})
+
+	t.Run("Problems", func(t *testing.T) {
+		mux := server.New(func(o *server.Options) { o.Problems = true })
+
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/unknown", nil))
+
+		if v := recorder.Header().Get("Content-Type"); recorder.Code != http.StatusNotFound || v != "application/problem+json" {
+			t.Errorf("Unexpected Response (%d): %s", recorder.Code, v)
+		}
+	})
+}
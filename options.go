@@ -22,9 +22,29 @@ type Globals struct {
 type Options struct {
 	Globals Globals
 
-	// Metadata will enable [Multiplexer] metadata to get added as a context key during route registration. Defaults to true.
+	// Metadata will enable [Mux] metadata to get added as a context key during route registration. Defaults to true.
+	//
+	// 	- See [Mux.Register] for the route-level setting.
 	Metadata bool
 
+	// Name represents an optional, user-defined route name. Added to the route's context metadata when [Options.Metadata] is enabled.
+	Name string
+
+	// Strip will remove the prefix from the request's URL path prior to calling a [Mux.Mount] handler. Defaults to true.
+	Strip bool
+
+	// NotFound represents the [http.Handler] called when no route matches the request's path. Defaults to a JSON response
+	// (see [Options.Problems]).
+	NotFound http.Handler
+
+	// MethodNotAllowed represents the [http.Handler] called when a route matches the request's path, but not its method. The
+	// "Allow" response header is established prior to calling the handler. Defaults to a JSON response (see [Options.Problems]).
+	MethodNotAllowed http.Handler
+
+	// Problems will render the default [Options.NotFound] and [Options.MethodNotAllowed] responses as RFC 9457 problem
+	// details ("application/problem+json"). Defaults to false.
+	Problems bool
+
 	// Middleware to wrap the route's [http.Handler] implementation(s) with. For configuring middleware that should be added to all
 	// of a [Mux] [http.Handler] implementation(s), see [Options.Globals], [Globals].
 	Middleware []func(http.Handler) http.Handler
@@ -44,6 +64,7 @@ func options() *Options {
 		},
 
 		Metadata:   true,
+		Strip:      true,
 		Middleware: make([]func(http.Handler) http.Handler, 0),
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"sync"
 
 	"github.com/go-playground/validator/v10"
 
@@ -13,6 +14,11 @@ import (
 	"github.com/x-ethr/server/types"
 )
 
+// standard returns the default validator used by [Validate] and [Handler] when none is provided.
+var standard = sync.OnceValue(func() *validator.Validate {
+	return validator.New(validator.WithRequiredStructEnabled())
+})
+
 // Handle represents a function type that takes a pointer to [types.CTX] as an argument.
 //
 //   - The function is used to handle the processing of an HTTP request and produce a response.
@@ -34,48 +40,26 @@ type Handle func(x *types.CTX)
 // to use [types.CTX] [types.CTX.Input] function to retrieve a hydrated instance of the input data structure from the request's body.
 //
 //   - The [types.CTX.Input] value will be a pointer to the [Input] Generic specification from the caller.
+//   - The request's body is decoded according to its Content-Type; see [types.Bind] and [types.Options] for the
+//     configurable decoder(s), strict mode and body size limit.
+//   - Field(s) tagged `path:"..."`, `query:"..."` or `header:"..."` are bound from the request's parameter(s) prior to
+//     validation; see [types.Parameters].
+//   - If v is nil, a default validator is used.
+//   - Invalid input responds per [types.Invalid.Response] without calling handle.
 func Validate[Input interface{}](w http.ResponseWriter, r *http.Request, v *validator.Validate, handle Handle, settings ...types.Variadic) {
-	ctx := r.Context()
-
-	output, redirect, exception := channels()
-
-	invalid := make(chan *types.Invalid)
-
-	var input Input
-	if message, validators, e := types.Validate(ctx, v, r.Body, &input); e != nil {
-		invalid <- &types.Invalid{Validators: validators, Message: message, Source: e}
+	if v == nil {
+		v = standard()
 	}
 
-	slog.DebugContext(ctx, "Request", slog.Any("input", input))
-
-	o := types.Configuration(w, r, &input, output, redirect, exception)
-	for _, option := range settings {
-		option(o)
-	}
+	var input Input
 
-	o.CTX.Context(ctx)
+	process(w, r, &input, func(o *types.Options) *types.Invalid {
+		invalid := types.Bind(r, v, &input, o)
 
-	go handle(o.CTX)
+		slog.DebugContext(r.Context(), "Request", slog.Any("input", input))
 
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case response := <-output:
-			evaluate(ctx, w, r, response)
-			return
-		case response := <-redirect:
-			redirection(ctx, w, r, response)
-			return
-		case e := <-invalid:
-			slog.WarnContext(ctx, "Invalid Request", slog.String("error", e.Error()), slog.String("path", r.URL.Path), slog.String("method", r.Method), slog.Any("input", input))
-			e.Response(w)
-			return
-		case e := <-exception:
-			throw(ctx, w, r, e)
-			return
-		}
-	}
+		return invalid
+	}, handle, settings...)
 }
 
 // Process is a function that handles the processing of an HTTP request. It takes an http.ResponseWriter, an *http.Request,
@@ -84,18 +68,95 @@ func Validate[Input interface{}](w http.ResponseWriter, r *http.Request, v *vali
 // waiting for either a response or an exception. If a response is received, it writes the response to the writer
 // and returns. If an exception is received, it logs the error and returns an HTTP error with the corresponding status code.
 func Process(w http.ResponseWriter, r *http.Request, handle Handle, settings ...types.Variadic) {
-	ctx := r.Context()
+	process(w, r, nil, nil, handle, settings...)
+}
+
+// Handler returns a generic, type-safe [http.HandlerFunc]. The request's body and parameter(s) are bound into a new In
+// and validated using v (see [Validate]); handle then receives a [types.Typed] context exposing the input as *In, and
+// accepting an Out payload.
+//
+//   - If v is nil, a default validator is used.
+//   - Validation failure(s) respond with validator(s) derived from the validation error(s) (see [types.Options.Derive]),
+//     unlike [Validate].
+//   - Request(s) without a body skip decoding, but are nonetheless validated; e.g. a "required" field of In fails.
+//   - Invalid input responds per [types.Invalid.Response] without calling handle.
+//   - The function is named Handler, rather than Handle, as the latter names the [Handle] function type.
+//
+// Example:
+//
+//	mux.Register("POST /v1/users", server.Handler(v, func(x *types.Typed[User, Created]) {
+//		user := x.Input()
+//		x.Complete(http.StatusCreated, Created{ID: user.ID})
+//	}))
+func Handler[In, Out interface{}](v *validator.Validate, handle func(x *types.Typed[In, Out]), settings ...types.Variadic) http.HandlerFunc {
+	if v == nil {
+		v = standard()
+	}
+
+	// Validation failure(s) respond with derived validator(s) by default; see [types.Options.Derive].
+	settings = append([]types.Variadic{func(o *types.Options) { o.Derive = true }}, settings...)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		var input In
+
+		process(w, r, &input, func(o *types.Options) *types.Invalid {
+			return types.Bind(r, v, &input, o)
+		}, func(x *types.CTX) {
+			handle(types.Wrap[In, Out](x))
+		}, settings...)
+	}
+}
+
+// process evaluates handle, writing its response, redirect or exception. If bind is non-nil, it's evaluated prior to
+// handle using the configured [types.Options]; an invalid request is responded to immediately, without calling handle.
+func process(w http.ResponseWriter, r *http.Request, input interface{}, bind func(o *types.Options) *types.Invalid, handle Handle, settings ...types.Variadic) {
+	// The handler's context is cancelled once a response has been written, releasing any handler blocked on completion.
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
 
 	output, redirect, exception := channels()
 
-	o := types.Configuration(w, r, nil, output, redirect, exception)
+	o := types.Configuration(w, r, input, output, redirect, exception)
 	for _, option := range settings {
 		option(o)
 	}
 
+	// cleanup removes the temporary file(s) of a multipart form parsed by bind. The [http.Server] only does so for the
+	// original request, whereas r may be a copy (e.g. via [http.Request.WithContext]).
+	cleanup := func() {}
+
+	if bind != nil {
+		previous := r.MultipartForm
+
+		invalid := bind(o)
+
+		if form := r.MultipartForm; form != nil && form != previous {
+			cleanup = func() {
+				if e := form.RemoveAll(); e != nil {
+					slog.WarnContext(ctx, "Unable to Remove Multipart Form File(s)", slog.String("error", e.Error()))
+				}
+			}
+		}
+
+		if invalid != nil {
+			defer cleanup()
+
+			slog.WarnContext(ctx, "Invalid Request", slog.String("error", invalid.Error()), slog.String("path", r.URL.Path), slog.String("method", r.Method))
+
+			invalid.Response(w)
+
+			return
+		}
+	}
+
 	o.CTX.Context(ctx)
 
-	go handle(o.CTX)
+	// The handler may outlive the response (e.g. following a cancellation); its input's file(s) are removed once it returns.
+	go func() {
+		defer cleanup()
+
+		recovery(ctx, o.CTX, handle)
+	}()
 
 	for {
 		select {
@@ -0,0 +1,505 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/goleak"
+
+	"github.com/x-ethr/server"
+	"github.com/x-ethr/server/types"
+)
+
+type user struct {
+	Name  string `json:"name" validate:"required"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+type created struct {
+	Name string `json:"name"`
+}
+
+// helped is a [types.Helper], whose validator(s) take precedence over those derived from the validation error(s).
+type helped struct {
+	Name  string `json:"name" validate:"required"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+func (helped) Help() types.Validators {
+	return types.Validators{"name": {Valid: false, Message: "a name is required"}}
+}
+
+func TestHandler(t *testing.T) {
+	handler := server.Handler(nil, func(x *types.Typed[user, created]) {
+		x.Complete(http.StatusCreated, created{Name: x.Input().Name})
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"test","email":"test@example.com"}`)))
+
+		if recorder.Code != http.StatusCreated {
+			t.Fatalf("Unexpected Status Code: %d", recorder.Code)
+		}
+
+		var output created
+		if e := json.NewDecoder(recorder.Body).Decode(&output); e != nil || output.Name != "test" {
+			t.Errorf("Unexpected Output: %+v, %v", output, e)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"test","email":"invalid"}`)))
+
+		if recorder.Code != http.StatusBadRequest {
+			t.Errorf("Expected Bad Request, Received: %d", recorder.Code)
+		}
+
+		// Input(s) without a [types.Helper] respond with validator(s) derived from the validation error(s).
+		var validators types.Validators
+		if e := json.NewDecoder(recorder.Body).Decode(&validators); e != nil {
+			t.Fatalf("Unable to Decode Validators: %v", e)
+		}
+
+		if email, ok := validators["Email"]; !(ok) || email.Valid || email.Value != "invalid" {
+			t.Errorf("Unexpected Validators: %+v", validators)
+		}
+	})
+
+	t.Run("Helper", func(t *testing.T) {
+		handler := server.Handler(nil, func(x *types.Typed[helped, created]) {
+			x.Complete(http.StatusCreated, created{Name: x.Input().Name})
+		})
+
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email":"test@example.com"}`)))
+
+		if recorder.Code != http.StatusBadRequest {
+			t.Fatalf("Expected Bad Request, Received: %d", recorder.Code)
+		}
+
+		var validators types.Validators
+		if e := json.NewDecoder(recorder.Body).Decode(&validators); e != nil {
+			t.Fatalf("Unable to Decode Validators: %v", e)
+		}
+
+		if name, ok := validators["name"]; !(ok) || name.Message != "a name is required" || len(validators) != 1 {
+			t.Errorf("Expected Helper's Validators, Received: %+v", validators)
+		}
+	})
+
+	t.Run("Bodyless", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", nil))
+
+		if recorder.Code != http.StatusBadRequest {
+			t.Errorf("Expected Validation of a Request Without a Body, Received: %d", recorder.Code)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		handler := server.Handler(nil, func(x *types.Typed[struct{}, []string]) {
+			x.Complete(http.StatusOK, []string{"a", "b"})
+		})
+
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		if recorder.Code != http.StatusOK || strings.TrimSpace(recorder.Body.String()) != `["a","b"]` {
+			t.Errorf("Unexpected Response: %d, %s", recorder.Code, recorder.Body.String())
+		}
+	})
+}
+
+func TestValidate(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	type attachment struct {
+		Document *multipart.FileHeader `form:"document"`
+	}
+
+	type upload struct {
+		Name  string                `form:"name" xml:"name" validate:"required"`
+		Tags  []string              `form:"tag" xml:"tag"`
+		Count int                   `form:"count" xml:"count"`
+		File  *multipart.FileHeader `form:"file" xml:"-"`
+
+		Attachment *attachment `xml:"-"`
+	}
+
+	var received upload
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.Validate[upload](w, r, nil, func(x *types.CTX) {
+			input, _ := x.Input()
+
+			received = *(input.(*upload))
+
+			x.Complete(&types.Response{Status: http.StatusOK, Payload: "ok"})
+		}, func(o *types.Options) {
+			o.Strict = r.URL.Query().Has("strict")
+			o.Derive = r.URL.Query().Has("derive")
+			o.Limit = 1024
+		})
+	})
+
+	serve := func(body io.Reader, content string, target string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, target, body)
+		if content != "" {
+			request.Header.Set("Content-Type", content)
+		}
+
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	tests := []struct {
+		name    string
+		body    string
+		content string
+		target  string
+		status  int
+	}{
+		{name: "Invalid", body: `{"Tags":["a"]}`, status: http.StatusServiceUnavailable},
+		{name: "Derived", body: `{"Tags":["a"]}`, target: "/?derive", status: http.StatusBadRequest},
+		{name: "Malformed", body: `{"Name":`, content: "application/json", status: http.StatusUnprocessableEntity},
+		{name: "Unknown", body: `{"Name":"a","Unknown":1}`, status: http.StatusOK},
+		{name: "Strict", body: `{"Name":"a","Unknown":1}`, target: "/?strict", status: http.StatusUnprocessableEntity},
+		{name: "Limit", body: `{"Name":"` + strings.Repeat("a", 2048) + `"}`, status: http.StatusRequestEntityTooLarge},
+		{name: "Unsupported", body: `a`, content: "text/csv", status: http.StatusUnsupportedMediaType},
+		{name: "Form", body: "name=a&tag=b&tag=c&count=2", content: "application/x-www-form-urlencoded", status: http.StatusOK},
+		{name: "Form-Strict", body: "name=a&other=b", content: "application/x-www-form-urlencoded", target: "/?strict", status: http.StatusUnprocessableEntity},
+		{name: "XML", body: "<upload><name>a</name><tag>b</tag><tag>c</tag><count>2</count></upload>", content: "application/xml", status: http.StatusOK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			target := test.target
+			if target == "" {
+				target = "/"
+			}
+
+			received = upload{}
+
+			recorder := serve(strings.NewReader(test.body), test.content, target)
+			if recorder.Code != test.status {
+				t.Fatalf("Unexpected Status Code: %d, %s", recorder.Code, recorder.Body.String())
+			}
+
+			if test.name == "Form" || test.name == "XML" {
+				if received.Name != "a" || len(received.Tags) != 2 || received.Tags[1] != "c" || received.Count != 2 {
+					t.Errorf("Unexpected Input: %+v", received)
+				}
+			}
+		})
+	}
+
+	t.Run("Form-Delete", func(t *testing.T) {
+		received = upload{}
+
+		request := httptest.NewRequest(http.MethodDelete, "/", strings.NewReader("name=a&tag=b&count=2"))
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, request)
+
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Unexpected Status Code: %d, %s", recorder.Code, recorder.Body.String())
+		}
+
+		if received.Name != "a" || len(received.Tags) != 1 || received.Count != 2 {
+			t.Errorf("Unexpected Input: %+v", received)
+		}
+	})
+
+	t.Run("Multipart", func(t *testing.T) {
+		var body bytes.Buffer
+
+		writer := multipart.NewWriter(&body)
+		writer.WriteField("name", "a")
+
+		file, _ := writer.CreateFormFile("file", "test.txt")
+		file.Write([]byte("content"))
+
+		document, _ := writer.CreateFormFile("document", "document.txt")
+		document.Write([]byte("content"))
+
+		writer.Close()
+
+		recorder := serve(&body, writer.FormDataContentType(), "/?strict")
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Unexpected Status Code: %d, %s", recorder.Code, recorder.Body.String())
+		}
+
+		if received.Name != "a" || received.File == nil || received.File.Filename != "test.txt" {
+			t.Errorf("Unexpected Input: %+v", received)
+		}
+
+		if received.Attachment == nil || received.Attachment.Document == nil || received.Attachment.Document.Filename != "document.txt" {
+			t.Errorf("Unexpected Nested File: %+v", received.Attachment)
+		}
+	})
+}
+
+func TestMultipartCleanup(t *testing.T) {
+	directory := t.TempDir()
+
+	t.Setenv("TMPDIR", directory)
+
+	type upload struct {
+		File *multipart.FileHeader `form:"file"`
+	}
+
+	// temporary returns the number of multipart temporary file(s) in directory.
+	temporary := func() int {
+		entries, e := os.ReadDir(directory)
+		if e != nil {
+			t.Fatalf("Unable to Read Temporary Directory: %v", e)
+		}
+
+		return len(entries)
+	}
+
+	var stored int
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.Validate[upload](w, r, nil, func(x *types.CTX) {
+			stored = temporary()
+
+			x.Complete(&types.Response{Status: http.StatusOK, Payload: "ok"})
+		}, func(o *types.Options) {
+			o.Memory = 1024
+		})
+	})
+
+	var body bytes.Buffer
+
+	writer := multipart.NewWriter(&body)
+
+	file, _ := writer.CreateFormFile("file", "large.txt")
+	file.Write(bytes.Repeat([]byte("a"), 64<<10))
+
+	writer.Close()
+
+	request := httptest.NewRequest(http.MethodPost, "/", &body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Unexpected Status Code: %d, %s", recorder.Code, recorder.Body.String())
+	}
+
+	if stored == 0 {
+		t.Fatalf("Expected File Larger than Memory to be Stored in a Temporary File")
+	}
+
+	// The temporary file(s) are removed once the handler's goroutine returns, which may follow the response.
+	deadline := time.Now().Add(time.Second)
+	for temporary() > 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Temporary File(s) Not Removed: %d", temporary())
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestParameters(t *testing.T) {
+	type paging struct {
+		Limit int `query:"limit"`
+	}
+
+	type sorting struct {
+		Order string `query:"order"`
+	}
+
+	type query struct {
+		ID     int           `path:"id" validate:"gt=0"`
+		Page   int           `query:"page"`
+		Tags   []string      `query:"tag"`
+		Since  time.Time     `query:"since"`
+		Window time.Duration `query:"window"`
+		Tenant string        `header:"X-Tenant" validate:"required"`
+		Hosts  []string      `header:"X-Host"`
+		Origin net.IP        `query:"origin"`
+		Name   string        `json:"name"`
+
+		Paging  *paging
+		Sorting *sorting
+	}
+
+	var received query
+
+	mux := http.NewServeMux()
+	mux.Handle("POST /items/{id}", server.Handler(nil, func(x *types.Typed[query, string]) {
+		received = *(x.Input())
+
+		x.Complete(http.StatusOK, "ok")
+	}))
+
+	serve := func(target string, tenant string) int {
+		request := httptest.NewRequest(http.MethodPost, target, strings.NewReader(`{"name":"test"}`))
+		if tenant != "" {
+			request.Header.Set("X-Tenant", tenant)
+		}
+
+		request.Header.Add("X-Host", "a.example.com")
+		request.Header.Add("X-Host", "b.example.com")
+
+		recorder := httptest.NewRecorder()
+
+		mux.ServeHTTP(recorder, request)
+
+		return recorder.Code
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		if status := serve("/items/7?page=2&tag=a&tag=b&since=2024-01-02&window=1h&origin=192.0.2.1&limit=10", "tenant"); status != http.StatusOK {
+			t.Fatalf("Unexpected Status Code: %d", status)
+		}
+
+		since := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		if received.ID != 7 || received.Page != 2 || len(received.Tags) != 2 || !(received.Since.Equal(since)) || received.Window != time.Hour || received.Tenant != "tenant" || received.Name != "test" {
+			t.Errorf("Unexpected Input: %+v", received)
+		}
+
+		if len(received.Hosts) != 2 || received.Hosts[1] != "b.example.com" {
+			t.Errorf("Unexpected Header Slice: %v", received.Hosts)
+		}
+
+		if !(received.Origin.Equal(net.ParseIP("192.0.2.1"))) {
+			t.Errorf("Unexpected Text Unmarshaler: %v", received.Origin)
+		}
+
+		// Nested struct pointer(s) are only allocated if one of their field(s) is bound.
+		if received.Paging == nil || received.Paging.Limit != 10 || received.Sorting != nil {
+			t.Errorf("Unexpected Nested Input: %+v, %+v", received.Paging, received.Sorting)
+		}
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		targets := map[string]string{
+			"Integer":  "/items/7?page=invalid",
+			"Time":     "/items/7?since=yesterday",
+			"Duration": "/items/7?window=1y",
+			"Text":     "/items/7?origin=invalid",
+			"Nested":   "/items/7?limit=invalid",
+		}
+
+		for name, target := range targets {
+			t.Run(name, func(t *testing.T) {
+				if status := serve(target, "tenant"); status != http.StatusBadRequest {
+					t.Errorf("Expected Bad Request, Received: %d", status)
+				}
+			})
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		if status := serve("/items/0", "tenant"); status != http.StatusBadRequest {
+			t.Errorf("Expected Bad Request, Received: %d", status)
+		}
+
+		if status := serve("/items/7", ""); status != http.StatusBadRequest {
+			t.Errorf("Expected Bad Request, Received: %d", status)
+		}
+	})
+}
+
+func TestRecovery(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.Process(w, r, func(x *types.CTX) {
+			var values map[string]string
+
+			values["key"] = "value" // assignment to a nil map panics
+		})
+	})
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("Expected Internal Server Error, Received: %d", recorder.Code)
+	}
+}
+
+// The completion method(s) retain their original signature(s), e.g. for use as function value(s).
+var (
+	_ func(*types.CTX, *types.Response)  = (*types.CTX).Complete
+	_ func(*types.CTX, *types.Redirect)  = (*types.CTX).Redirect
+	_ func(*types.CTX, *types.Exception) = (*types.CTX).Error
+)
+
+func TestCompletion(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	t.Run("Duplicate", func(t *testing.T) {
+		result := make(chan error, 1)
+
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			server.Process(w, r, func(x *types.CTX) {
+				x.Complete(&types.Response{Status: http.StatusOK, Payload: "first"})
+
+				result <- x.TryComplete(&types.Response{Status: http.StatusOK, Payload: "second"})
+			})
+		})
+
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		if e := <-result; !(errors.Is(e, types.Completed)) {
+			t.Errorf("Expected Duplicate Completion Error, Received: %v", e)
+		}
+
+		if body := recorder.Body.String(); body != "first" {
+			t.Errorf("Unexpected Response Body: %s", body)
+		}
+	})
+
+	t.Run("Cancellation", func(t *testing.T) {
+		result := make(chan error, 1)
+
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			server.Process(w, r, func(x *types.CTX) {
+				time.Sleep(50 * time.Millisecond)
+
+				result <- x.TryError(&types.Exception{Code: http.StatusInternalServerError})
+			})
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx))
+
+		if e := <-result; !(errors.Is(e, context.Canceled) || errors.Is(e, context.DeadlineExceeded)) {
+			t.Errorf("Expected Context Error, Received: %v", e)
+		}
+	})
+}
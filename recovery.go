@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/x-ethr/server/middleware/route"
+	"github.com/x-ethr/server/types"
+)
+
+// panics counts the panic(s) recovered from [Handle] goroutine(s). See [recovery].
+var panics, _ = otel.Meter("github.com/x-ethr/server").Int64Counter("server.handler.panics", metric.WithDescription("The number of panic(s) recovered from request handler(s)."))
+
+// recovery calls handle, converting a panic into an [http.StatusInternalServerError] [types.Exception]. Because handle runs
+// in its own goroutine, middleware(s) can't recover its panic(s); without recovery, a panic terminates the process.
+//
+//   - The panic and its stack trace are logged, recorded on the request's active span, and counted via the
+//     "server.handler.panics" metric - attributed by the low-cardinality [semconv.HTTPRoute] path and the request's method.
+//   - The exception is abandoned if the request has already been completed, or its context is done.
+func recovery(ctx context.Context, x *types.CTX, handle Handle) {
+	defer func() {
+		v := recover()
+		if v == nil {
+			return
+		}
+
+		e, ok := v.(error)
+		if !(ok) {
+			e = fmt.Errorf("%v", v)
+		}
+
+		stack := string(debug.Stack())
+
+		var pattern, path string
+		if value := route.New().Value(ctx); value != nil {
+			pattern, path = value.Pattern, value.Path
+		}
+
+		slog.ErrorContext(ctx, "Panic While Processing Request", slog.String("error", e.Error()), slog.String("route", pattern), slog.String("stack", stack))
+
+		span := trace.SpanFromContext(ctx)
+		span.RecordError(e, trace.WithAttributes(attribute.String("exception.stacktrace", stack)))
+		span.SetStatus(codes.Error, "panic")
+
+		if panics != nil {
+			panics.Add(ctx, 1, metric.WithAttributes(semconv.HTTPRoute(path), semconv.HTTPRequestMethodKey.String(x.Request().Method)))
+		}
+
+		x.Error(&types.Exception{Code: http.StatusInternalServerError, Source: e, Log: "Recovered Handler Panic"})
+	}()
+
+	handle(x)
+}
@@ -2,42 +2,146 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"log/slog"
 	"net"
 	"net/http"
-	"time"
+	"os"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 
+	"github.com/x-ethr/server/certificate"
 	"github.com/x-ethr/server/internal/writer"
 )
 
-// Server initializes a http.Server with application-specific configuration.
-func Server(ctx context.Context, handler http.Handler, middleware *Middlewares, port string) *http.Server {
-	handler = writer.Handle(middleware.Handler(handler))
+// Server initializes an [Instance] (an [http.Server] and its listener) with application-specific configuration. Middleware(s) are expected to be composed by the
+// handler (see [Mux]).
+//
+//   - See [Settings] for the optional configuration, and its default(s).
+//   - If the [Client.CA] bundle can't be read, or doesn't contain a valid PEM certificate, the error is returned by
+//     [Instance.Listen] (and [Instance.ListenAndServe]).
+func Server(ctx context.Context, handler http.Handler, port string, configuration ...Setting) *Instance {
+	var s = settings()
+	for _, option := range configuration {
+		option(s)
+	}
+
+	handler = writer.Handle(handler)
+
+	options := []otelhttp.Option{otelhttp.WithMessageEvents(otelhttp.ReadEvents, otelhttp.WriteEvents)}
+	if s.Name != "" {
+		options = append(options, otelhttp.WithServerName(s.Name))
+	}
+
+	handler = otelhttp.NewHandler(handler, "server", options...)
+
+	var encryption *tls.Config
+	var watcher *certificate.Watcher
+	var failure error
+	if s.TLS != nil {
+		var e error
+
+		watcher, e = certificate.New(ctx, s.TLS.Certificate, s.TLS.Key, func(o *certificate.Settings) {
+			o.Interval = s.TLS.Interval
+		})
+
+		if e != nil {
+			slog.WarnContext(ctx, "TLS Certificate Unavailable - Continuing to Poll for Certificate", slog.String("error", e.Error()))
+		}
+
+		encryption = &tls.Config{
+			MinVersion:     s.TLS.MinVersion,
+			CipherSuites:   s.TLS.CipherSuites,
+			GetCertificate: watcher.GetCertificate,
+		}
+
+		if encryption.MinVersion == 0 {
+			encryption.MinVersion = tls.VersionTLS12
+		}
+
+		if client := s.TLS.Client; client != nil {
+			encryption.ClientAuth = client.Policy
+			if encryption.ClientAuth == tls.NoClientCert {
+				encryption.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+
+			// An empty pool would either reject every client, or - with a weaker policy - accept every client unverified.
+			if encryption.ClientCAs, failure = authorities(client.CA); failure != nil {
+				slog.ErrorContext(ctx, "Invalid Client Certificate Authority Bundle", slog.String("path", client.CA), slog.String("error", failure.Error()))
+
+				encryption.ClientCAs = x509.NewCertPool()
+			}
+		}
+	}
 
-	if v, ok := ctx.Value("server-name").(string); ok {
-		handler = otelhttp.NewHandler(handler, "server", otelhttp.WithServerName(v), otelhttp.WithMessageEvents(otelhttp.ReadEvents, otelhttp.WriteEvents))
-	} else {
-		handler = otelhttp.NewHandler(handler, "server", otelhttp.WithMessageEvents(otelhttp.ReadEvents, otelhttp.WriteEvents))
+	var h2 *http2.Server
+	if s.HTTP2 != nil {
+		h2 = &http2.Server{
+			MaxConcurrentStreams: s.HTTP2.MaxConcurrentStreams,
+			MaxReadFrameSize:     s.HTTP2.MaxReadFrameSize,
+			IdleTimeout:          s.Timeouts.Idle,
+		}
+
+		if h2.MaxConcurrentStreams == 0 {
+			h2.MaxConcurrentStreams = 250
+		}
+
+		if s.HTTP2.Cleartext {
+			handler = h2c.NewHandler(handler, h2)
+		} else if encryption == nil {
+			slog.WarnContext(ctx, "HTTP/2 Settings Ignored - Neither TLS nor Cleartext HTTP/2 Configured")
+		}
 	}
 
-	return &http.Server{
-		Addr:                         fmt.Sprintf("0.0.0.0:%s", port),
+	connections := tracker()
+
+	instance := &http.Server{
+		Addr:                         net.JoinHostPort(s.Host, port),
 		Handler:                      handler,
 		DisableGeneralOptionsHandler: false,
-		TLSConfig:                    nil,
-		ReadTimeout:                  15 * time.Second,
-		ReadHeaderTimeout:            0,
-		WriteTimeout:                 60 * time.Second,
-		IdleTimeout:                  30 * time.Second,
-		MaxHeaderBytes:               http.DefaultMaxHeaderBytes,
+		TLSConfig:                    encryption,
+		ReadTimeout:                  s.Timeouts.Read,
+		ReadHeaderTimeout:            s.Timeouts.Header,
+		WriteTimeout:                 s.Timeouts.Write,
+		IdleTimeout:                  s.Timeouts.Idle,
+		MaxHeaderBytes:               s.MaxHeaderBytes,
 		TLSNextProto:                 nil,
-		ConnState:                    nil,
-		ErrorLog:                     nil,
+		ConnState:                    connections.track,
+		ErrorLog:                     s.ErrorLog,
 		BaseContext: func(net.Listener) context.Context {
 			return ctx
 		},
-		ConnContext: nil,
+		ConnContext: s.ConnContext,
+	}
+
+	if h2 != nil && encryption != nil {
+		if e := http2.ConfigureServer(instance, h2); e != nil {
+			slog.ErrorContext(ctx, "Unable to Configure HTTP/2 Server", slog.String("error", e.Error()))
+		}
+	}
+
+	i := &Instance{Server: instance, ctx: ctx, settings: s, watcher: watcher, connections: connections, failure: failure, listening: make(chan struct{})}
+
+	connections.observe(ctx, i.attributes)
+
+	return i
+}
+
+// authorities reads the PEM-encoded certificate authority bundle at path (see [Client.CA]) into a certificate pool.
+func authorities(path string) (*x509.CertPool, error) {
+	bundle, e := os.ReadFile(path)
+	if e != nil {
+		return nil, fmt.Errorf("unable to read client certificate authority bundle (%s): %w", path, e)
 	}
+
+	pool := x509.NewCertPool()
+	if !(pool.AppendCertsFromPEM(bundle)) {
+		return nil, fmt.Errorf("invalid client certificate authority bundle (%s)", path)
+	}
+
+	return pool, nil
 }
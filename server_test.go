@@ -0,0 +1,418 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"golang.org/x/net/http2"
+
+	"github.com/x-ethr/server"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Defaults", func(t *testing.T) {
+		instance := server.Server(ctx, http.NotFoundHandler(), "8080")
+
+		if instance.Server.Addr != "0.0.0.0:8080" {
+			t.Errorf("Unexpected Address: %s", instance.Server.Addr)
+		}
+
+		if instance.Addr() != nil {
+			t.Errorf("Unexpected Listener Address Prior to Listening: %s", instance.Addr())
+		}
+
+		if instance.ReadHeaderTimeout <= 0 {
+			t.Errorf("Expected Default Read-Header Timeout, Received: %s", instance.ReadHeaderTimeout)
+		}
+
+		if instance.ErrorLog == nil {
+			t.Errorf("Expected Default Error Logger")
+		}
+	})
+
+	t.Run("Error-Log-Default", func(t *testing.T) {
+		instance := server.Server(ctx, http.NotFoundHandler(), "8080")
+
+		// A default logger configured after construction must still receive the server's error(s).
+		previous := slog.Default()
+		defer slog.SetDefault(previous)
+
+		var buffer bytes.Buffer
+		slog.SetDefault(slog.New(slog.NewTextHandler(&buffer, nil)))
+
+		instance.ErrorLog.Print("http: TLS handshake error")
+
+		if output := buffer.String(); !(strings.Contains(output, "level=WARN")) || !(strings.Contains(output, "TLS handshake error")) {
+			t.Errorf("Expected Error Log Forwarded to Current Default Logger, Received: %q", output)
+		}
+	})
+
+	t.Run("Settings", func(t *testing.T) {
+		instance := server.Server(ctx, http.NotFoundHandler(), "8080", func(s *server.Settings) {
+			s.Host = "::1"
+			s.Timeouts.Header = time.Second
+			s.MaxHeaderBytes = 1 << 10
+		})
+
+		if instance.Server.Addr != "[::1]:8080" {
+			t.Errorf("Unexpected Address: %s", instance.Server.Addr)
+		}
+
+		if instance.ReadHeaderTimeout != time.Second || instance.MaxHeaderBytes != 1<<10 {
+			t.Errorf("Settings Not Applied: %s, %d", instance.ReadHeaderTimeout, instance.MaxHeaderBytes)
+		}
+	})
+}
+
+func TestServerClientCA(t *testing.T) {
+	ctx := context.Background()
+
+	invalid := filepath.Join(t.TempDir(), "ca.pem")
+	if e := os.WriteFile(invalid, []byte("invalid"), 0o600); e != nil {
+		t.Fatal(e)
+	}
+
+	for _, path := range []string{filepath.Join(t.TempDir(), "missing.pem"), invalid} {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			instance := server.Server(ctx, http.NotFoundHandler(), "0", func(s *server.Settings) {
+				s.Host = "127.0.0.1"
+				s.TLS = &server.TLS{Certificate: "certificate.pem", Key: "key.pem", Client: &server.Client{CA: path}}
+			})
+
+			defer instance.Close()
+
+			if _, e := instance.Listen(); e == nil {
+				t.Errorf("Expected Error for Client Certificate Authority Bundle: %s", path)
+			} else if addr := instance.Addr(); addr != nil {
+				t.Errorf("Unexpected Listener (%s) for Invalid Client Certificate Authority Bundle", addr)
+			}
+
+			if e := instance.ListenAndServe(); e == nil || errors.Is(e, http.ErrServerClosed) {
+				t.Errorf("Expected ListenAndServe Error for Client Certificate Authority Bundle: %s, Received: %v", path, e)
+			}
+		})
+	}
+}
+
+func TestServerListener(t *testing.T) {
+	ctx := context.Background()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+
+	t.Run("Ephemeral", func(t *testing.T) {
+		instance := server.Server(ctx, handler, "0", func(s *server.Settings) {
+			s.Host = "127.0.0.1"
+		})
+
+		go instance.ListenAndServe()
+		defer instance.Close()
+
+		<-instance.Listening()
+
+		address := instance.Addr().(*net.TCPAddr)
+		if address.Port == 0 {
+			t.Fatalf("Expected Ephemeral Port Assignment")
+		}
+
+		response, e := http.Get("http://" + address.String())
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			t.Errorf("Unexpected Status Code: %d", response.StatusCode)
+		}
+	})
+
+	t.Run("Unix", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "server.sock")
+
+		instance := server.Server(ctx, handler, "", func(s *server.Settings) {
+			s.Listener.Network = "unix"
+			s.Listener.Path = path
+			s.Listener.Permissions = 0o600
+		})
+
+		go instance.ListenAndServe()
+		defer instance.Close()
+
+		<-instance.Listening()
+
+		information, e := os.Stat(path)
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if information.Mode().Perm() != 0o600 {
+			t.Errorf("Unexpected Socket Permissions: %s", information.Mode().Perm())
+		}
+
+		client := &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, "unix", path)
+				},
+			},
+		}
+
+		response, e := client.Get("http://unix/")
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			t.Errorf("Unexpected Status Code: %d", response.StatusCode)
+		}
+	})
+
+	t.Run("Systemd", func(t *testing.T) {
+		instance := server.Server(ctx, handler, "", func(s *server.Settings) {
+			s.Listener.Network = "systemd"
+		})
+
+		if _, e := instance.Listen(); e == nil {
+			t.Errorf("Expected Error Without Inherited Socket(s)")
+		}
+
+		select {
+		case <-instance.Listening():
+		default:
+			t.Errorf("Expected Listening to Close Following a Failure")
+		}
+	})
+
+	t.Run("Systemd-Foreign", func(t *testing.T) {
+		// The activation environment of another process (e.g. the parent of an upgrade) is rejected, and unset.
+		t.Setenv("LISTEN_PID", strconv.Itoa(os.Getppid()))
+		t.Setenv("LISTEN_FDS", "1")
+		t.Setenv("LISTEN_FDNAMES", "http")
+
+		instance := server.Server(ctx, handler, "", func(s *server.Settings) {
+			s.Listener.Network = "systemd"
+		})
+
+		if _, e := instance.Listen(); e == nil {
+			t.Errorf("Expected Error for Another Process's Socket(s)")
+		}
+
+		for _, key := range []string{"LISTEN_PID", "LISTEN_FDS", "LISTEN_FDNAMES"} {
+			if _, ok := os.LookupEnv(key); ok {
+				t.Errorf("Expected Unset Environment Variable: %s", key)
+			}
+		}
+	})
+
+	t.Run("Unix-Stale", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "server.sock")
+
+		stale, e := net.Listen("unix", path)
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		stale.(*net.UnixListener).SetUnlinkOnClose(false)
+		stale.Close()
+
+		instance := server.Server(ctx, handler, "", func(s *server.Settings) {
+			s.Listener.Network = "unix"
+			s.Listener.Path = path
+		})
+
+		listener, e := instance.Listen()
+		if e != nil {
+			t.Fatalf("Expected Stale Socket Removal, Received: %v", e)
+		}
+
+		listener.Close()
+	})
+
+	t.Run("Unix-In-Use", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "server.sock")
+
+		live, e := net.Listen("unix", path)
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		defer live.Close()
+
+		instance := server.Server(ctx, handler, "", func(s *server.Settings) {
+			s.Listener.Network = "unix"
+			s.Listener.Path = path
+		})
+
+		if _, e := instance.Listen(); e == nil {
+			t.Fatalf("Expected Error for a Live Socket")
+		}
+
+		if _, e := os.Stat(path); e != nil {
+			t.Errorf("Expected Live Socket to Remain: %v", e)
+		}
+	})
+}
+
+func TestServerCleartextHTTP2(t *testing.T) {
+	ctx := context.Background()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Proto))
+	})
+
+	instance := server.Server(ctx, handler, "0", func(s *server.Settings) {
+		s.Host = "127.0.0.1"
+		s.HTTP2 = &server.HTTP2{Cleartext: true}
+	})
+
+	go instance.ListenAndServe()
+	defer instance.Close()
+
+	<-instance.Listening()
+
+	client := &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, address string, _ *tls.Config) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, address)
+			},
+		},
+	}
+
+	response, e := client.Get("http://" + instance.Addr().String())
+	if e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	defer response.Body.Close()
+
+	body, _ := io.ReadAll(response.Body)
+	if string(body) != "HTTP/2.0" {
+		t.Errorf("Expected Protocol (HTTP/2.0), Received: %s", body)
+	}
+}
+
+func TestServerConnections(t *testing.T) {
+	ctx := context.Background()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+
+	instance := server.Server(ctx, handler, "0", func(s *server.Settings) {
+		s.Host = "127.0.0.1"
+		s.Limits.Client = 1
+	})
+
+	go instance.ListenAndServe()
+	defer instance.Close()
+
+	<-instance.Listening()
+
+	client := &http.Client{Transport: &http.Transport{MaxConnsPerHost: 1}}
+	defer client.CloseIdleConnections()
+
+	response, e := client.Get("http://" + instance.Addr().String())
+	if e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	io.Copy(io.Discard, response.Body)
+	response.Body.Close()
+
+	deadline := time.Now().Add(time.Second)
+	for instance.Connections()["idle"] != 1 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if count := instance.Connections()["idle"]; count != 1 {
+		t.Errorf("Expected Idle Connection Count (1), Received: %d", count)
+	}
+
+	// A second connection from the same client exceeds the per-client limit.
+	connection, e := net.Dial("tcp", instance.Addr().String())
+	if e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	defer connection.Close()
+
+	connection.SetReadDeadline(time.Now().Add(time.Second))
+	if _, e := connection.Read(make([]byte, 1)); e != io.EOF {
+		t.Errorf("Expected Rejected Connection (EOF), Received: %v", e)
+	}
+}
+
+func TestServerConnectionMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	reader := metric.NewManualReader()
+
+	otel.SetMeterProvider(metric.NewMeterProvider(metric.WithReader(reader)))
+
+	// listeners returns the distinct "server.listener" attribute value(s) of the "server.connections" gauge.
+	listeners := func() map[string]bool {
+		var data metricdata.ResourceMetrics
+		if e := reader.Collect(ctx, &data); e != nil {
+			t.Fatalf("Unable to Collect Metrics: %v", e)
+		}
+
+		listeners := make(map[string]bool)
+		for _, scope := range data.ScopeMetrics {
+			for _, m := range scope.Metrics {
+				if gauge, ok := m.Data.(metricdata.Gauge[int64]); ok && m.Name == "server.connections" {
+					for _, point := range gauge.DataPoints {
+						if value, ok := point.Attributes.Value("server.listener"); ok {
+							listeners[value.AsString()] = true
+						}
+					}
+				}
+			}
+		}
+
+		return listeners
+	}
+
+	instances := make([]*server.Instance, 2)
+	for index := range instances {
+		instances[index] = server.Server(ctx, http.NotFoundHandler(), "0", func(s *server.Settings) { s.Host = "127.0.0.1" })
+		if _, e := instances[index].Listen(); e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		defer instances[index].Close()
+	}
+
+	// Instance(s) of other test(s) may also be reported; only this test's instance(s) are evaluated.
+	addresses := []string{"tcp://" + instances[0].Addr().String(), "tcp://" + instances[1].Addr().String()}
+	if values := listeners(); addresses[0] == addresses[1] || !(values[addresses[0]]) || !(values[addresses[1]]) {
+		t.Fatalf("Expected Distinct Listener Attribute(s) %v, Received: %v", addresses, values)
+	}
+
+	instances[0].Close()
+
+	if values := listeners(); values[addresses[0]] || !(values[addresses[1]]) {
+		t.Errorf("Expected Only the Open Instance's Listener (%s), Received: %v", addresses[1], values)
+	}
+}
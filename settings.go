@@ -0,0 +1,215 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"time"
+)
+
+// Timeouts represents the [http.Server] timeout configuration(s).
+type Timeouts struct {
+	// Read represents the maximum duration for reading an entire request, including the body. Defaults to 15 seconds.
+	Read time.Duration
+
+	// Header represents the maximum duration for reading a request's headers. Defaults to 5 seconds.
+	//
+	// 	- A zero value falls back to [Timeouts.Read]; if both are zero, slow clients can hold connections open indefinitely.
+	Header time.Duration
+
+	// Write represents the maximum duration before timing out writes of a response. Defaults to 60 seconds.
+	Write time.Duration
+
+	// Idle represents the maximum duration to wait for the next request when keep-alives are enabled. Defaults to 30 seconds.
+	Idle time.Duration
+}
+
+// TLS represents the server's TLS configuration. Certificate(s) are served via a [certificate.Watcher], and therefore
+// rotated certificate and key file(s) are reloaded without restarting the server.
+//
+//   - When configured, [Instance.ListenAndServe] serves TLS.
+type TLS struct {
+	// Certificate represents the PEM-encoded certificate (chain) file path.
+	Certificate string
+
+	// Key represents the PEM-encoded private key file path.
+	Key string
+
+	// MinVersion represents the minimum TLS version. Defaults to [tls.VersionTLS12].
+	MinVersion uint16
+
+	// CipherSuites represents the enabled TLS 1.0 - 1.2 cipher suite(s); TLS 1.3 cipher suites aren't configurable. Defaults
+	// to nil, which uses Go's secure default(s).
+	CipherSuites []uint16
+
+	// Interval represents the polling interval used to detect rotated certificate and key file(s). Defaults to 30 seconds.
+	Interval time.Duration
+
+	// Client represents the optional mutual-TLS client authentication configuration. Defaults to nil (no client certificates
+	// are requested).
+	Client *Client
+}
+
+// Client represents the mutual-TLS client authentication configuration. See the [peer] middleware for exposing the
+// verified client's identity to handler(s).
+type Client struct {
+	// CA represents the PEM-encoded certificate authority bundle file path used to verify client certificate(s). The bundle
+	// is read once, during [Server] construction; if it's unreadable or invalid, [Instance.Listen] returns the error.
+	CA string
+
+	// Policy represents the client certificate verification policy. Defaults to [tls.RequireAndVerifyClientCert].
+	//
+	// 	- [tls.VerifyClientCertIfGiven] allows a mixture of authenticated, and unauthenticated client(s); routes can then
+	//	  enforce client certificate(s) via the [peer] middleware's Required setting.
+	Policy tls.ClientAuthType
+}
+
+// HTTP2 represents the server's HTTP/2 configuration.
+//
+//   - HTTP/2 is only served with [Settings.TLS], or with [HTTP2.Cleartext] enabled. Otherwise, the server speaks HTTP/1.1
+//     only, and the remaining setting(s) are ignored (a warning is logged during [Server] construction).
+type HTTP2 struct {
+	// Cleartext enables h2c (HTTP/2 without TLS), via either prior-knowledge or an HTTP/1.1 "Upgrade: h2c" request. Typically
+	// enabled for mesh-internal traffic where a proxy (e.g. Envoy) speaks HTTP/2 to the pod. Defaults to false.
+	Cleartext bool
+
+	// MaxConcurrentStreams represents the maximum number of concurrent streams per connection. Defaults to 250.
+	MaxConcurrentStreams uint32
+
+	// MaxReadFrameSize represents the largest frame the server is willing to read; valid values are between 16KiB and 16MiB.
+	// Defaults to 0, which uses the [http2.Server] default (1MiB).
+	MaxReadFrameSize uint32
+}
+
+// Listener represents the server's listener configuration.
+type Listener struct {
+	// Network represents the listener's network, and must be one of:
+	//
+	// 	- "tcp" (default), "tcp4" or "tcp6": listens on the [Settings.Host] and port. A port of "0" selects an ephemeral
+	//	  port; see [Instance.Addr] for the chosen address.
+	// 	- "unix": listens on the Unix domain socket at [Listener.Path].
+	// 	- "systemd": uses a socket inherited via systemd socket activation ("LISTEN_FDS").
+	Network string
+
+	// Path represents the Unix domain socket's file path. Only applicable to the "unix" network.
+	Path string
+
+	// Permissions represents the Unix domain socket's file mode. Only applicable to the "unix" network. Defaults to 0660.
+	Permissions os.FileMode
+
+	// Name represents the systemd socket's name (see FileDescriptorName, "LISTEN_FDNAMES") to select. Only applicable to
+	// the "systemd" network. Defaults to an empty string, which selects the first inherited socket.
+	Name string
+}
+
+// Limits represents the server's connection limit(s). Connection count(s) are reported via the "server.connections"
+// metric irrespective of the configured limit(s), attributed by the instance's "server.listener" address (and
+// "server.name", if set) until the instance is shut down or closed.
+type Limits struct {
+	// Connections represents the maximum number of concurrent connection(s). Once reached, new connection(s) wait in the
+	// listener's backlog until an existing connection closes. Defaults to 0 (unlimited).
+	Connections int
+
+	// Client represents the maximum number of concurrent connection(s) per client IP address. Connection(s) beyond the
+	// limit are closed immediately. Defaults to 0 (unlimited).
+	//
+	//   - Behind a proxy or load-balancer, every connection shares the proxy's address; configure accordingly.
+	Client int
+}
+
+// Settings is the configuration structure optionally mutated via the [Setting] constructor used by [Server].
+type Settings struct {
+	// Host represents the listening address' host. Defaults to "0.0.0.0".
+	Host string
+
+	// Name represents the server name used for telemetry; see [otelhttp.WithServerName]. Defaults to an empty string.
+	Name string
+
+	// Listener represents the listener configuration. Defaults to a TCP listener.
+	Listener Listener
+
+	// Timeouts represents the [http.Server] timeout configuration(s).
+	Timeouts Timeouts
+
+	// Limits represents the connection limit(s). Defaults to unlimited.
+	Limits Limits
+
+	// MaxHeaderBytes represents the maximum number of bytes the server will read parsing a request's headers, including
+	// the request line. Defaults to [http.DefaultMaxHeaderBytes].
+	MaxHeaderBytes int
+
+	// ErrorLog represents the [http.Server] error logger. Defaults to a [log.Logger] that forwards to the default [slog.Logger]'s
+	// handler at [slog.LevelWarn] (see [slog.NewLogLogger]). The default logger is looked up per message, so a later call
+	// to [slog.SetDefault] still applies.
+	ErrorLog *log.Logger
+
+	// TLS represents the optional TLS configuration. Defaults to nil (plain-text HTTP).
+	TLS *TLS
+
+	// HTTP2 represents the optional HTTP/2 configuration. Defaults to nil; TLS server(s) still negotiate HTTP/2 using the
+	// [http.Server] default(s).
+	HTTP2 *HTTP2
+
+	// ConnContext optionally modifies the context used for a new connection. See [http.Server.ConnContext].
+	ConnContext func(ctx context.Context, c net.Conn) context.Context
+}
+
+// Setting represents a functional constructor for the [Settings] type. Typical callers of Setting won't need to perform
+// nil checks as all implementations first construct a [Settings] reference using packaged default(s).
+type Setting func(s *Settings)
+
+// settings represents a default constructor.
+func settings() *Settings {
+	return &Settings{
+		Host: "0.0.0.0",
+		Listener: Listener{
+			Network:     "tcp",
+			Permissions: 0o660,
+		},
+		Timeouts: Timeouts{
+			Read:   15 * time.Second,
+			Header: 5 * time.Second,
+			Write:  60 * time.Second,
+			Idle:   30 * time.Second,
+		},
+		MaxHeaderBytes: http.DefaultMaxHeaderBytes,
+		ErrorLog:       slog.NewLogLogger(forward{}, slog.LevelWarn),
+	}
+}
+
+// forward is a [slog.Handler] that delegates to the default [slog.Logger]'s handler at the time of each call, replaying
+// any attribute(s) and group(s) added via [slog.Handler.WithAttrs] and [slog.Handler.WithGroup].
+type forward struct {
+	chain []func(slog.Handler) slog.Handler
+}
+
+// handler returns the current default handler, with the forward's attribute(s) and group(s) applied.
+func (f forward) handler() slog.Handler {
+	handler := slog.Default().Handler()
+	for _, derive := range f.chain {
+		handler = derive(handler)
+	}
+
+	return handler
+}
+
+func (f forward) Enabled(ctx context.Context, level slog.Level) bool {
+	return f.handler().Enabled(ctx, level)
+}
+
+func (f forward) Handle(ctx context.Context, record slog.Record) error {
+	return f.handler().Handle(ctx, record)
+}
+
+func (f forward) WithAttrs(attributes []slog.Attr) slog.Handler {
+	return forward{chain: append(slices.Clip(f.chain), func(h slog.Handler) slog.Handler { return h.WithAttrs(attributes) })}
+}
+
+func (f forward) WithGroup(name string) slog.Handler {
+	return forward{chain: append(slices.Clip(f.chain), func(h slog.Handler) slog.Handler { return h.WithGroup(name) })}
+}
@@ -0,0 +1,252 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+)
+
+// Supervisor runs several [Instance](s) - e.g. a public API, an internal admin server, a metrics server - and arbitrary
+// background runner(s) under a single [Lifecycle]. See [Supervise] for construction.
+//
+//   - A failure of any server or runner initiates a shutdown of all the others.
+//   - A single signal handler (see [Interrupt]) drains and shuts down every server together.
+type Supervisor struct {
+	ctx           context.Context
+	configuration []Graceful
+
+	mutex   sync.Mutex
+	servers []supervised
+	runners []runner
+	hooks   []Hook
+	reloads []Hook
+
+	background context.Context
+	stop       context.CancelFunc
+	running    sync.WaitGroup
+}
+
+type supervised struct {
+	name     string
+	instance *Instance
+}
+
+type runner struct {
+	name     string
+	function func(ctx context.Context) error
+}
+
+// Supervise constructs a [Supervisor] using the optional [Graceful] shutdown settings. See [Supervisor.Run].
+func Supervise(ctx context.Context, configuration ...Graceful) *Supervisor {
+	return &Supervisor{
+		ctx:           ctx,
+		configuration: configuration,
+		servers:       make([]supervised, 0),
+		runners:       make([]runner, 0),
+		hooks:         make([]Hook, 0),
+		reloads:       make([]Hook, 0),
+	}
+}
+
+// Server adds a named [Instance] to the supervisor. The name also identifies the server's listener across an upgrade (see
+// [Lifecycle.Upgrade]), and therefore should be unique, and consistent across release(s).
+func (s *Supervisor) Server(name string, instance *Instance) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	instance.name = name
+
+	s.servers = append(s.servers, supervised{name: name, instance: instance})
+}
+
+// Go adds a named background runner to the supervisor. The runner's context is cancelled once a shutdown is initiated,
+// after which it should return promptly.
+//
+//   - A runner returning a non-nil error, other than [context.Canceled], initiates a shutdown.
+//   - A runner returning nil doesn't affect any other server or runner.
+func (s *Supervisor) Go(name string, function func(ctx context.Context) error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.runners = append(s.runners, runner{name: name, function: function})
+}
+
+// Register adds shutdown hook(s), evaluated after every server has shut down. See [Lifecycle.Register].
+func (s *Supervisor) Register(hooks ...Hook) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.hooks = append(s.hooks, hooks...)
+}
+
+// OnReload adds reload hook(s). In addition to these, every server configured with [Settings.TLS] reloads its certificate.
+// See [Lifecycle.OnReload].
+func (s *Supervisor) OnReload(hooks ...Hook) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.reloads = append(s.reloads, hooks...)
+}
+
+// Run starts every server and runner, and blocks until all have been shut down - either by signal, the cancellation of the
+// supervisor's context, or a failure. Run returns the failure(s), if any, joined with any shutdown error(s).
+func (s *Supervisor) Run() error {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	s.mutex.Lock()
+	servers := append(make([]supervised, 0, len(s.servers)), s.servers...)
+	runners := append(make([]runner, 0, len(s.runners)), s.runners...)
+	hooks := append(make([]Hook, 0, len(s.hooks)), s.hooks...)
+	reloads := append(make([]Hook, 0, len(s.reloads)), s.reloads...)
+
+	s.background, s.stop = context.WithCancel(ctx)
+	s.mutex.Unlock()
+
+	lifecycle := Interrupt(ctx, cancel, s, s.configuration...)
+	lifecycle.Register(hooks...)
+	lifecycle.OnReload(reloads...)
+
+	for index := range servers {
+		if servers[index].instance.watcher != nil {
+			lifecycle.OnReload(Hook{Name: fmt.Sprintf("tls (%s)", servers[index].name), Function: servers[index].instance.Reload})
+		}
+	}
+
+	var mutex sync.Mutex
+	var failures []error
+
+	fail := func(e error) {
+		mutex.Lock()
+		failures = append(failures, e)
+		mutex.Unlock()
+
+		slog.ErrorContext(ctx, "Supervised Process Failure - Initializing Shutdown", slog.String("error", e.Error()))
+
+		lifecycle.Stop()
+	}
+
+	for index := range servers {
+		server := servers[index]
+
+		go func() {
+			if e := server.instance.ListenAndServe(); e != nil && !(errors.Is(e, http.ErrServerClosed)) {
+				fail(fmt.Errorf("server (%s): %w", server.name, e))
+			}
+		}()
+	}
+
+	for index := range runners {
+		runner := runners[index]
+
+		s.running.Add(1)
+		go func() {
+			defer s.running.Done()
+
+			if e := runner.function(s.background); e != nil && !(errors.Is(e, context.Canceled)) {
+				fail(fmt.Errorf("runner (%s): %w", runner.name, e))
+			}
+		}()
+	}
+
+	go func() {
+		select {
+		case <-s.ctx.Done():
+			lifecycle.Stop()
+		case <-lifecycle.Done():
+		}
+	}()
+
+	e := lifecycle.Wait()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return errors.Join(append(failures, e)...)
+}
+
+// Shutdown cancels every runner's context, and gracefully shuts down every server concurrently. Shutdown satisfies
+// [Shutdowner], and is typically called by the supervisor's [Lifecycle] rather than directly.
+func (s *Supervisor) Shutdown(ctx context.Context) error {
+	s.mutex.Lock()
+	servers := append(make([]supervised, 0, len(s.servers)), s.servers...)
+	stop := s.stop
+	s.mutex.Unlock()
+
+	if stop != nil {
+		stop()
+	}
+
+	var mutex sync.Mutex
+	var errs []error
+
+	var group sync.WaitGroup
+	for index := range servers {
+		server := servers[index]
+
+		group.Add(1)
+		go func() {
+			defer group.Done()
+
+			if e := server.instance.Shutdown(ctx); e != nil {
+				mutex.Lock()
+				errs = append(errs, fmt.Errorf("server (%s): %w", server.name, e))
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	group.Wait()
+
+	runners := make(chan struct{})
+	go func() {
+		s.running.Wait()
+		close(runners)
+	}()
+
+	select {
+	case <-runners:
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("runner(s): %w", ctx.Err()))
+	}
+
+	return errors.Join(errs...)
+}
+
+// SetKeepAlivesEnabled controls HTTP keep-alive(s) for every server. See [http.Server.SetKeepAlivesEnabled].
+func (s *Supervisor) SetKeepAlivesEnabled(v bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for index := range s.servers {
+		s.servers[index].instance.SetKeepAlivesEnabled(v)
+	}
+}
+
+// listeners returns every server's listener, if listening. See [Lifecycle.Upgrade].
+func (s *Supervisor) listeners() (map[string]net.Listener, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	listeners := make(map[string]net.Listener)
+	for index := range s.servers {
+		v, e := s.servers[index].instance.listeners()
+		if e != nil {
+			return nil, e
+		}
+
+		for key, listener := range v {
+			if _, exists := listeners[key]; exists {
+				return nil, fmt.Errorf("server (%s): duplicate listener (%s)", s.servers[index].name, key)
+			}
+
+			listeners[key] = listener
+		}
+	}
+
+	return listeners, nil
+}
@@ -0,0 +1,84 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/x-ethr/server"
+)
+
+func TestSupervisor(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+
+	local := func(s *server.Settings) {
+		s.Host = "127.0.0.1"
+	}
+
+	quiet := func(s *server.Shutdown) {
+		s.Signals = nil
+	}
+
+	t.Run("Failure", func(t *testing.T) {
+		ctx := context.Background()
+
+		public, admin := server.Server(ctx, handler, "0", local), server.Server(ctx, handler, "0", local)
+
+		failure := errors.New("failure")
+
+		supervisor := server.Supervise(ctx, quiet)
+		supervisor.Server("public", public)
+		supervisor.Server("admin", admin)
+		supervisor.Go("worker", func(ctx context.Context) error {
+			<-public.Listening()
+			<-admin.Listening()
+
+			return failure
+		})
+
+		if e := supervisor.Run(); !(errors.Is(e, failure)) {
+			t.Fatalf("Expected Runner Failure, Received: %v", e)
+		}
+
+		for _, instance := range []*server.Instance{public, admin} {
+			if _, e := http.Get("http://" + instance.Addr().String()); e == nil {
+				t.Errorf("Expected Server (%s) to Be Shut Down", instance.Addr())
+			}
+		}
+	})
+
+	t.Run("Cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		public := server.Server(ctx, handler, "0", local)
+
+		var stopped bool
+
+		supervisor := server.Supervise(ctx, quiet)
+		supervisor.Server("public", public)
+		supervisor.Go("worker", func(ctx context.Context) error {
+			<-ctx.Done()
+
+			stopped = true
+
+			return ctx.Err()
+		})
+
+		go func() {
+			<-public.Listening()
+
+			cancel()
+		}()
+
+		if e := supervisor.Run(); e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if !(stopped) {
+			t.Errorf("Expected Runner to Stop")
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Bind decodes the request's body into data, a pointer, using the [Decoder] matching the request's Content-Type (see
+// [Options.Decoders]); binds the request's path, query and header parameter(s) (see [Parameters]); then validates data
+// using v. Bind returns nil if the request is valid.
+//
+//   - Request(s) without a body skip decoding, but are still bound and validated.
+//   - Validation is skipped if v is nil, or if data doesn't point to a struct.
+//   - An unsupported Content-Type, or a body exceeding [Options.Limit], results in an [Invalid] with the respective
+//     [Invalid.Status]; a malformed body results in an [Invalid] with a Message.
+func Bind(r *http.Request, v *validator.Validate, data interface{}, o *Options) *Invalid {
+	ctx := r.Context()
+
+	if r.Body != nil && r.Body != http.NoBody {
+		d, media, ok := decoder(r, o.Decoders)
+		if !(ok) {
+			slog.WarnContext(ctx, "Unsupported Request Media Type", slog.String("content-type", media))
+
+			return &Invalid{Status: http.StatusUnsupportedMediaType, Source: fmt.Errorf("unsupported media type: %s", media)}
+		}
+
+		if o.Limit > 0 {
+			r.Body = http.MaxBytesReader(o.CTX.Writer(), r.Body, o.Limit)
+		}
+
+		if e := d(r, data, o); e != nil && !(errors.Is(e, io.EOF)) {
+			var size *http.MaxBytesError
+			if errors.As(e, &size) {
+				slog.WarnContext(ctx, "Request Body Exceeds Limit", slog.Int64("limit", size.Limit))
+
+				return &Invalid{Status: http.StatusRequestEntityTooLarge, Source: e}
+			}
+
+			slog.WarnContext(ctx, "Unable to Decode Request Body", slog.String("content-type", media), slog.String("error", e.Error()))
+
+			return &Invalid{Message: fmt.Sprintf("Valid %s Required as Input", media), Source: e}
+		}
+	}
+
+	if !(structure(data)) {
+		return nil
+	}
+
+	if e := Parameters(r, data); e != nil {
+		var mismatch *Mismatch
+		if errors.As(e, &mismatch) {
+			slog.WarnContext(ctx, "Invalid Request Parameter", slog.String("source", mismatch.Source), slog.String("name", mismatch.Name), slog.String("error", e.Error()))
+
+			return &Invalid{Validators: Validators{mismatch.Name: {Value: mismatch.Values, Valid: false, Message: e.Error()}}, Source: e}
+		}
+
+		slog.ErrorContext(ctx, "Unable to Bind Request Parameter(s)", slog.String("error", e.Error()))
+
+		return &Invalid{Message: "Internal Validation Error", Source: e}
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	if message, validators, e := check(ctx, v, data, o.Derive); e != nil {
+		return &Invalid{Message: message, Validators: validators, Source: e}
+	}
+
+	return nil
+}
+
+// Parameters binds the request's parameter(s) into the exported, tagged field(s) of data, a pointer to a struct:
+//
+//   - `path:"id"` binds the Go 1.22 [http.ServeMux] wildcard, see [http.Request.PathValue].
+//   - `query:"page"` binds the URL's query parameter; slice field(s) receive every value (e.g. "?tag=a&tag=b").
+//   - `header:"X-Tenant"` binds the request header; slice field(s) receive every value.
+//
+// Parameters that are absent leave the field unchanged. Untagged nested struct(s), and pointer(s) to them, are traversed;
+// a nil pointer is only allocated if one of its field(s) is bound. See [Mismatch] for value(s) that can't be parsed.
+func Parameters(r *http.Request, data interface{}) error {
+	query := r.URL.Query()
+
+	sources := []struct {
+		tag    string
+		lookup func(name string) ([]string, bool)
+	}{
+		{tag: "path", lookup: func(name string) ([]string, bool) {
+			value := r.PathValue(name)
+			return []string{value}, value != ""
+		}},
+		{tag: "query", lookup: lookup(query)},
+		{tag: "header", lookup: func(name string) ([]string, bool) {
+			values := r.Header.Values(name)
+			return values, len(values) > 0
+		}},
+	}
+
+	for _, source := range sources {
+		if e := populate(data, source.tag, source.lookup, nil); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// structure reports whether data is a struct, or a pointer to one.
+func structure(data interface{}) bool {
+	t := reflect.TypeOf(data)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t != nil && t.Kind() == reflect.Struct
+}
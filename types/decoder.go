@@ -0,0 +1,160 @@
+package types
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Decoder represents a function that decodes an HTTP request's body into data, a pointer. See [Options.Decoders].
+type Decoder func(r *http.Request, data interface{}, o *Options) error
+
+// Decoders returns the default [Decoder](s), keyed by media type.
+func Decoders() map[string]Decoder {
+	return map[string]Decoder{
+		"application/json":                  JSON,
+		"application/x-www-form-urlencoded": Form,
+		"multipart/form-data":               Multipart,
+		"application/xml":                   XML,
+		"text/xml":                          XML,
+	}
+}
+
+// JSON decodes the request's JSON body into data. If [Options.Strict], unknown field(s) are rejected.
+func JSON(r *http.Request, data interface{}, o *Options) error {
+	decoder := json.NewDecoder(r.Body)
+	if o.Strict {
+		decoder.DisallowUnknownFields()
+	}
+
+	return decoder.Decode(data)
+}
+
+// XML decodes the request's XML body into data.
+func XML(r *http.Request, data interface{}, o *Options) error {
+	return xml.NewDecoder(r.Body).Decode(data)
+}
+
+// Form decodes the request's url-encoded form body into data, a pointer to a struct. Field(s) are named by their "form"
+// tag, falling back to their "json" tag, and then their Go name. If [Options.Strict], unknown key(s) are rejected.
+//
+//   - Unlike [http.Request.ParseForm], the body is decoded irrespective of the request's method (e.g. DELETE).
+//   - The decoded value(s) are established as the request's [http.Request.PostForm].
+func Form(r *http.Request, data interface{}, o *Options) error {
+	body, e := io.ReadAll(r.Body)
+	if e != nil {
+		return e
+	}
+
+	r.PostForm, e = url.ParseQuery(string(body))
+	if e != nil {
+		return e
+	}
+
+	known := make(map[string]bool)
+	if e := populate(data, "form", lookup(r.PostForm), known); e != nil {
+		return e
+	}
+
+	if o.Strict {
+		return unknown(r.PostForm, known)
+	}
+
+	return nil
+}
+
+// Multipart decodes the request's multipart form body into data, a pointer to a struct, as with [Form]. Additionally,
+// field(s) of type *[multipart.FileHeader] or []*[multipart.FileHeader] are assigned the form's file(s). Up to
+// [Options.Memory] byte(s) are held in memory; the remainder is stored in temporary file(s).
+//
+//   - The caller is responsible for removing the temporary file(s) (see [multipart.Form.RemoveAll]); the server's
+//     handler(s) do so once the handler returns.
+func Multipart(r *http.Request, data interface{}, o *Options) error {
+	memory := o.Memory
+	if memory <= 0 {
+		memory = o.Limit
+	}
+
+	if memory <= 0 {
+		memory = 32 << 20
+	}
+
+	if e := r.ParseMultipartForm(memory); e != nil {
+		return e
+	}
+
+	known := make(map[string]bool)
+	if e := populate(data, "form", lookup(r.MultipartForm.Value), known); e != nil {
+		return e
+	}
+
+	files(data, r.MultipartForm, known)
+
+	if o.Strict {
+		if e := unknown(r.MultipartForm.Value, known); e != nil {
+			return e
+		}
+
+		for key := range r.MultipartForm.File {
+			if !(known[key]) {
+				return fmt.Errorf("unknown form file: %s", key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// decoder returns the [Decoder] matching the request's Content-Type; media types with a "+json" or "+xml" suffix fall
+// back to the respective "application/json" or "application/xml" decoder. Requests without a Content-Type are decoded
+// as JSON.
+func decoder(r *http.Request, decoders map[string]Decoder) (Decoder, string, bool) {
+	value := r.Header.Get("Content-Type")
+	if value == "" {
+		value = "application/json"
+	}
+
+	media, _, e := mime.ParseMediaType(value)
+	if e != nil {
+		return nil, value, false
+	}
+
+	if d, ok := decoders[media]; ok {
+		return d, media, true
+	}
+
+	switch {
+	case strings.HasSuffix(media, "+json"):
+		d, ok := decoders["application/json"]
+		return d, media, ok
+	case strings.HasSuffix(media, "+xml"):
+		d, ok := decoders["application/xml"]
+		return d, media, ok
+	}
+
+	return nil, media, false
+}
+
+// lookup returns a [populate] lookup function over values.
+func lookup(values map[string][]string) func(name string) ([]string, bool) {
+	return func(name string) ([]string, bool) {
+		v, ok := values[name]
+		return v, ok
+	}
+}
+
+// unknown returns an error for the first key of values not found in known.
+func unknown(values map[string][]string, known map[string]bool) error {
+	for key := range values {
+		if !(known[key]) {
+			return fmt.Errorf("unknown form field: %s", key)
+		}
+	}
+
+	return nil
+}
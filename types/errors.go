@@ -13,6 +13,7 @@ import (
 //     then the validator for the given request input is invalid.
 //   - Validators: a map of field names to validation results.
 //   - Source: the source error that caused the invalidation.
+//   - Status: an optional status code overriding the above, e.g. [http.StatusRequestEntityTooLarge].
 type Invalid struct {
 	// Message represents the validation's string error.
 	//
@@ -21,11 +22,16 @@ type Invalid struct {
 	Message    string     `json:"message,omitempty"`
 	Validators Validators `json:"validators,omitempty"`
 	Source     error      `json:"error,omitempty"` // Source represents the source error
+	Status     int        `json:"-"`               // Status represents an optional http status-code overriding the derived code
 }
 
 // Error returns a string representation of the Exception. If the Exception's Message is empty,
 // it returns the standard HTTP status-text for the given code.
 func (i *Invalid) Error() string {
+	if i.Status != 0 {
+		return fmt.Errorf("(%d) %s", i.Status, http.StatusText(i.Status)).Error()
+	}
+
 	exception := fmt.Errorf("(%d) %s: %s", http.StatusUnprocessableEntity, http.StatusText(http.StatusUnprocessableEntity), i.Message).Error()
 	if i.Message == "" {
 		exception = fmt.Errorf("(%d) %s", http.StatusBadRequest, http.StatusText(http.StatusBadRequest)).Error()
@@ -40,8 +46,14 @@ func (i *Invalid) Error() string {
 // Otherwise, if Message is present and not equal to "Internal Validation Error",
 // respond with status code 422 (Unprocessable Entity).
 // Otherwise, respond with status code 503 (Service Unavailable).
+//
+// If Status is specified, the response uses it regardless.
 func (i *Invalid) Response(w http.ResponseWriter) {
-	if i.Validators != nil {
+	if i.Status != 0 {
+		http.Error(w, i.Error(), i.Status)
+
+		return
+	} else if i.Validators != nil {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusBadRequest)
 		json.NewEncoder(w).Encode(i.Validators)
@@ -3,14 +3,41 @@ package types
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"net/http"
+	"sync/atomic"
 )
 
 var Null = errors.New("invalid nil pointer")
 
+// Completed represents an error returned by [CTX.TryComplete], [CTX.TryRedirect] or [CTX.TryError] when the request has
+// already been completed by a previous call to any of the completion method(s).
+var Completed = errors.New("request already completed")
+
 // Options is the configuration structure optionally mutated via the [Variadic] constructor used throughout the package.
 type Options struct {
 	CTX *CTX
+
+	// Decoders represents the request body [Decoder](s), keyed by media type (e.g. "application/json"). Defaults to
+	// [Decoders]. Request(s) with an unsupported Content-Type are rejected with [http.StatusUnsupportedMediaType].
+	Decoders map[string]Decoder
+
+	// Strict rejects request bodies containing unknown field(s) (see [encoding/json.Decoder.DisallowUnknownFields]). Defaults to false.
+	Strict bool
+
+	// Limit represents the maximum request body size, in bytes. Request(s) exceeding the limit are rejected with
+	// [http.StatusRequestEntityTooLarge]. Defaults to 1 MiB; a value <= 0 disables the limit.
+	Limit int64
+
+	// Derive responds to validation failure(s) of input(s) that don't implement [Helper] with validator(s) derived from the
+	// validation error(s): a 400 (Bad Request) with a JSON body keyed by field name, rather than a 503 (Service
+	// Unavailable). Defaults to false, except for the server package's generic Handler.
+	Derive bool
+
+	// Memory represents the maximum number of multipart form byte(s) held in memory; the remainder is stored in temporary
+	// file(s), which are removed once the handler returns. Defaults to 0, which uses [Options.Limit], or 32 MiB if the
+	// limit is disabled.
+	Memory int64
 }
 
 // Variadic represents a functional constructor for the [Options] type. Typical callers of Variadic won't need to perform
@@ -23,6 +50,8 @@ func Configuration(w http.ResponseWriter, r *http.Request, input interface{}, ou
 		CTX: &CTX{
 			w: w, r: r, input: input, output: output, redirect: redirect, exception: exception,
 		},
+		Decoders: Decoders(),
+		Limit:    1 << 20,
 	}
 }
 
@@ -34,6 +63,8 @@ type CTX struct {
 	output    chan<- *Response
 	redirect  chan<- *Redirect
 	exception chan<- *Exception
+
+	completed atomic.Bool
 }
 
 // Writer returns the http.ResponseWriter associated with the CTX object.
@@ -65,19 +96,60 @@ func (c *CTX) Input() (interface{}, error) {
 }
 
 // Complete is a wrapper around the channel *Response. It's up to consumers to return immediately following a call to [CTX.Complete].
+//
+//   - Only the first call to any of the completion method(s) completes the request; subsequent call(s) are ignored.
+//   - If the request's context is done prior to the response being accepted, the response is discarded.
+//   - See [CTX.TryComplete] for the outcome as an error.
 func (c *CTX) Complete(response *Response) {
-	c.output <- response
-	return
+	c.TryComplete(response)
 }
 
 // Redirect is a wrapper around the channel *Redirect. It's up to consumers to return immediately following a call to [CTX.Redirect].
+//
+//   - See [CTX.Complete] for the completion semantics, and [CTX.TryRedirect] for the outcome as an error.
 func (c *CTX) Redirect(response *Redirect) {
-	c.redirect <- response
-	return
+	c.TryRedirect(response)
 }
 
 // Error is a wrapper around the channel *Exception. It's up to consumers to return immediately following a call to [CTX.Error].
+//
+//   - See [CTX.Complete] for the completion semantics, and [CTX.TryError] for the outcome as an error.
 func (c *CTX) Error(exception *Exception) {
-	c.exception <- exception
-	return
+	c.TryError(exception)
+}
+
+// TryComplete is equivalent to [CTX.Complete], but reports its outcome:
+//
+//   - If the request was already completed, [Completed] is returned.
+//   - If the request's context is done prior to the response being accepted, the context's error is returned.
+func (c *CTX) TryComplete(response *Response) error {
+	return deliver(c, c.output, response)
+}
+
+// TryRedirect is equivalent to [CTX.Redirect], but reports its outcome; see [CTX.TryComplete] for the error(s).
+func (c *CTX) TryRedirect(response *Redirect) error {
+	return deliver(c, c.redirect, response)
+}
+
+// TryError is equivalent to [CTX.Error], but reports its outcome; see [CTX.TryComplete] for the error(s).
+func (c *CTX) TryError(exception *Exception) error {
+	return deliver(c, c.exception, exception)
+}
+
+// deliver sends v on channel, at most once per [CTX], unless the request's context is done first.
+func deliver[Value interface{}](c *CTX, channel chan<- Value, v Value) error {
+	ctx := c.r.Context()
+
+	if !(c.completed.CompareAndSwap(false, true)) {
+		slog.WarnContext(ctx, "Duplicate Request Completion", slog.String("path", c.r.URL.Path), slog.String("method", c.r.Method))
+
+		return Completed
+	}
+
+	select {
+	case channel <- v:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
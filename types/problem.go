@@ -0,0 +1,32 @@
+package types
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Problem represents an RFC 9457 problem details response body.
+type Problem struct {
+	Type     string `json:"type"`               // Type represents a URI reference identifying the problem type. Defaults to "about:blank".
+	Title    string `json:"title"`              // Title represents a short, human-readable summary of the problem type.
+	Status   int    `json:"status"`             // Status represents the HTTP status code.
+	Detail   string `json:"detail,omitempty"`   // Detail represents a human-readable explanation specific to this occurrence of the problem.
+	Instance string `json:"instance,omitempty"` // Instance represents a URI reference identifying the specific occurrence of the problem.
+}
+
+// Response writes the [Problem] as an "application/problem+json" response.
+func (p *Problem) Response(w http.ResponseWriter) {
+	if p.Type == "" {
+		p.Type = "about:blank"
+	}
+
+	if p.Title == "" {
+		p.Title = http.StatusText(p.Status)
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+
+	json.NewEncoder(w).Encode(p)
+}
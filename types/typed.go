@@ -0,0 +1,55 @@
+package types
+
+import "net/http"
+
+// Typed represents a generic, type-safe [CTX] for handling HTTP requests with an input of type In, and a response payload
+// of type Out. See the server package's Handler function for construction.
+type Typed[In, Out interface{}] struct {
+	ctx   *CTX
+	input *In
+}
+
+// Wrap constructs a [Typed] context from the untyped [CTX]. If the [CTX] input isn't of type *In, [Typed.Input] returns nil.
+func Wrap[In, Out interface{}](x *CTX) *Typed[In, Out] {
+	input, _ := x.input.(*In)
+
+	return &Typed[In, Out]{ctx: x, input: input}
+}
+
+// CTX returns the underlying, untyped [CTX].
+func (t *Typed[In, Out]) CTX() *CTX {
+	return t.ctx
+}
+
+// Writer returns the http.ResponseWriter associated with the request. See [CTX.Writer].
+func (t *Typed[In, Out]) Writer() http.ResponseWriter {
+	return t.ctx.Writer()
+}
+
+// Request returns the http.Request. See [CTX.Request].
+func (t *Typed[In, Out]) Request() *http.Request {
+	return t.ctx.Request()
+}
+
+// Input returns the request's decoded, validated input.
+func (t *Typed[In, Out]) Input() *In {
+	return t.input
+}
+
+// Complete responds with the given status code and output payload. It's up to consumers to return immediately following
+// a call to [Typed.Complete]. See [CTX.TryComplete] for the completion semantics, and error(s).
+func (t *Typed[In, Out]) Complete(status int, output Out) error {
+	return t.ctx.TryComplete(&Response{Status: status, Payload: output})
+}
+
+// Redirect redirects the request to url using the given status code. It's up to consumers to return immediately following
+// a call to [Typed.Redirect]. See [CTX.TryComplete] for the completion semantics, and error(s).
+func (t *Typed[In, Out]) Redirect(status int, url string) error {
+	return t.ctx.TryRedirect(&Redirect{Status: status, URL: url})
+}
+
+// Error responds with the given exception. It's up to consumers to return immediately following a call to [Typed.Error].
+// See [CTX.TryComplete] for the completion semantics, and error(s).
+func (t *Typed[In, Out]) Error(exception *Exception) error {
+	return t.ctx.TryError(exception)
+}
@@ -40,13 +40,11 @@ type Helper interface {
 // 2. Validates the data using the validator.
 // 3. If there are validation errors, logs each error and returns an appropriate response.
 // 4. If the data implements the Helper interface, returns the result of the Help method.
-// 5. Logs the data for debugging purposes.
-// 6. Returns nil if there were no exceptions generated.
+// 5. Returns nil if there were no exceptions generated.
 // The function returns a string message, a map of Validators, and an error.
+//
+// See [Bind] for Content-Type aware decoding.
 func Validate(ctx context.Context, v *validator.Validate, body io.Reader, data interface{}) (string, Validators, error) {
-	// invalid describes an invalid argument passed to `Struct`, `StructExcept`, StructPartial` or `Field`
-	var invalid *validator.InvalidValidationError
-
 	// Unmarshal request-body into "data".
 	if e := json.NewDecoder(body).Decode(&data); e != nil {
 		// Log an issue unmarshalling the body and return a Bad request exception.
@@ -57,18 +55,32 @@ func Validate(ctx context.Context, v *validator.Validate, body io.Reader, data i
 		return "Valid JSON Required as Input", nil, e
 	}
 
+	return check(ctx, v, data, false)
+}
+
+// check validates data using v, logging each validation error. If data doesn't implement [Helper], the returned
+// validators are derived from the validation errors when derive is true, and are otherwise nil (see [Options.Derive]).
+func check(ctx context.Context, v *validator.Validate, data interface{}, derive bool) (string, Validators, error) {
+	// invalid describes an invalid argument passed to `Struct`, `StructExcept`, StructPartial` or `Field`
+	var invalid *validator.InvalidValidationError
+
+	// errs describes the field(s) that failed validation
+	var errs validator.ValidationErrors
+
 	// Validate "data" using "validation".
 	if e := v.Struct(data); e != nil {
 		// Check if the error is due to an invalid validation configuration.
-		if errors.As(e, &invalid) {
+		if errors.As(e, &invalid) || !(errors.As(e, &errs)) {
 			// Log the issue and return an Internal server error exception.
 			slog.ErrorContext(ctx, "Invalid Validator", slog.String("error", e.Error()))
 
 			return "Internal Validation Error", nil, e
 		}
 
+		validators := make(Validators, len(errs))
+
 		// Loop through the validation errors, logging each one.
-		for key, e := range e.(validator.ValidationErrors) {
+		for key, e := range errs {
 			slog.Log(ctx, slog.LevelWarn, fmt.Sprintf("Validator (%d)", key), slog.Group("error",
 				slog.String("tag", e.Tag()),
 				slog.String("actual-tag", e.ActualTag()),
@@ -92,18 +104,21 @@ func Validate(ctx context.Context, v *validator.Validate, body io.Reader, data i
 					},
 				),
 			))
+
+			validators[e.Field()] = Validator{Value: e.Value(), Valid: false, Message: e.Error()}
 		}
 
 		if typecast, ok := data.(Helper); ok {
 			return "", typecast.Help(), e
 		}
 
+		if derive {
+			return "", validators, e
+		}
+
 		return "", nil, e
 	}
 
-	// If no exception was generated, log the "data" for debugging purposes.
-	// slog.Log(ctx, slog.LevelDebug, "Request", logging.Structure("body", data))
-
 	// Return nil if there were no exceptions generated.
 	return "", nil, nil
 }
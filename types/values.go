@@ -0,0 +1,343 @@
+package types
+
+import (
+	"encoding"
+	"fmt"
+	"mime/multipart"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// populate assigns the value(s) returned by lookup to the exported field(s) of the struct pointed to by data. A field's
+// name is derived from its tag (e.g. `query:"page"`); only "form" falls back to the field's "json" tag, and then its Go
+// name. Fields tagged "-" are skipped; nested struct(s), and pointer(s) to them, without a tag are traversed.
+//
+//   - Supported field type(s) include string(s), bool(s), integer(s), float(s), [time.Time] (RFC 3339 or date-only),
+//     [time.Duration], [encoding.TextUnmarshaler](s), slice(s) and pointer(s) of these.
+//   - A nil pointer to a nested struct is only allocated if one of its field(s) is assigned; a pointer to a struct
+//     already being traversed (e.g. a recursive type) is skipped.
+//   - The names of every field evaluated are added to known, if non-nil.
+//   - A value that can't be parsed results in a [*Mismatch] error.
+func populate(data interface{}, tag string, lookup func(name string) ([]string, bool), known map[string]bool) error {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return Null
+	}
+
+	v = v.Elem()
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("unsupported %s binding target: %s", tag, v.Type())
+	}
+
+	_, e := fields(v, tag, lookup, known, map[reflect.Type]bool{v.Type(): true})
+
+	return e
+}
+
+// fields assigns the value(s) returned by lookup to v's field(s), reporting whether any were assigned. See [populate].
+func fields(v reflect.Value, tag string, lookup func(name string) ([]string, bool), known map[string]bool, ancestors map[reflect.Type]bool) (bool, error) {
+	var assigned bool
+
+	t := v.Type()
+	for index := 0; index < t.NumField(); index++ {
+		field := t.Field(index)
+		if !(field.IsExported()) {
+			continue
+		}
+
+		name, tagged := key(field, tag)
+		if name == "-" {
+			continue
+		}
+
+		value := v.Field(index)
+
+		if !(tagged) && nested(field.Type) {
+			ok, e := descend(value, ancestors, func(v reflect.Value) (bool, error) {
+				return fields(v, tag, lookup, known, ancestors)
+			})
+
+			if e != nil {
+				return assigned, e
+			}
+
+			assigned = assigned || ok
+
+			continue
+		}
+
+		if !(tagged) && tag != "form" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		if known != nil {
+			known[name] = true
+		}
+
+		values, ok := lookup(name)
+		if !(ok) || len(values) == 0 {
+			continue
+		}
+
+		if e := assign(value, values); e != nil {
+			return assigned, &Mismatch{Source: tag, Name: name, Values: values, Err: e}
+		}
+
+		assigned = true
+	}
+
+	return assigned, nil
+}
+
+// key returns field's name according to its tag, and whether the tag is present. Only "form" falls back to the
+// field's "json" tag; the caller falls back to the field's Go name.
+func key(field reflect.StructField, tag string) (string, bool) {
+	name, tagged := field.Tag.Lookup(tag)
+	if !(tagged) && tag == "form" {
+		name, _ = field.Tag.Lookup("json")
+	}
+
+	name, _, _ = strings.Cut(name, ",")
+
+	return name, tagged
+}
+
+// nested reports whether t is a struct, or a pointer to one, whose field(s) are bound individually rather than as a
+// single value (e.g. [time.Time], or a [multipart.FileHeader]).
+func nested(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Struct && !(implements(t)) && t != reflect.TypeOf(multipart.FileHeader{})
+}
+
+// descend evaluates bind against the struct held by v, a struct or a pointer to one, reporting whether bind assigned
+// any field(s). A nil pointer is only allocated if so; a pointer to a type found in ancestors is skipped.
+func descend(v reflect.Value, ancestors map[reflect.Type]bool, bind func(v reflect.Value) (bool, error)) (bool, error) {
+	if v.Kind() != reflect.Pointer {
+		return bind(v)
+	}
+
+	t := v.Type().Elem()
+	if ancestors[t] {
+		return false, nil
+	}
+
+	ancestors[t] = true
+	defer delete(ancestors, t)
+
+	target := v
+	if v.IsNil() {
+		target = reflect.New(t)
+	}
+
+	assigned, e := bind(target.Elem())
+	if e == nil && assigned && v.IsNil() {
+		v.Set(target)
+	}
+
+	return assigned, e
+}
+
+// Mismatch represents a request value that couldn't be assigned to its input struct's field.
+type Mismatch struct {
+	Source string   // Source represents the value's origin, e.g. "path", "query", "header" or "form".
+	Name   string   // Name represents the value's key.
+	Values []string // Values represents the raw value(s).
+	Err    error    // Err represents the parsing error.
+}
+
+func (m *Mismatch) Error() string {
+	return fmt.Sprintf("invalid %s value (%s): %s", m.Source, m.Name, m.Err)
+}
+
+func (m *Mismatch) Unwrap() error {
+	return m.Err
+}
+
+// implements reports whether t (or *t) implements [encoding.TextUnmarshaler].
+func implements(t reflect.Type) bool {
+	unmarshaler := reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+	return t.Implements(unmarshaler) || reflect.PointerTo(t).Implements(unmarshaler)
+}
+
+// assign parses values into v according to v's type.
+func assign(v reflect.Value, values []string) error {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		return assign(v.Elem(), values)
+	}
+
+	switch v.Type() {
+	case reflect.TypeOf(time.Time{}):
+		t, e := timestamp(values[0])
+		if e != nil {
+			return e
+		}
+
+		v.Set(reflect.ValueOf(t))
+
+		return nil
+	case reflect.TypeOf(time.Duration(0)):
+		d, e := time.ParseDuration(values[0])
+		if e != nil {
+			return e
+		}
+
+		v.SetInt(int64(d))
+
+		return nil
+	}
+
+	if v.CanAddr() {
+		if unmarshaler, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			return unmarshaler.UnmarshalText([]byte(values[0]))
+		}
+	}
+
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+		slice := reflect.MakeSlice(v.Type(), len(values), len(values))
+		for index := range values {
+			if e := assign(slice.Index(index), values[index:index+1]); e != nil {
+				return e
+			}
+		}
+
+		v.Set(slice)
+
+		return nil
+	}
+
+	value := values[0]
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, e := strconv.ParseBool(value)
+		if e != nil {
+			return e
+		}
+
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, e := strconv.ParseInt(value, 10, v.Type().Bits())
+		if e != nil {
+			return e
+		}
+
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, e := strconv.ParseUint(value, 10, v.Type().Bits())
+		if e != nil {
+			return e
+		}
+
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, e := strconv.ParseFloat(value, v.Type().Bits())
+		if e != nil {
+			return e
+		}
+
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type: %s", v.Type())
+	}
+
+	return nil
+}
+
+// timestamp parses value as an RFC 3339 timestamp, or a date-only (e.g. "2006-01-02") value.
+func timestamp(value string) (time.Time, error) {
+	t, e := time.Parse(time.RFC3339Nano, value)
+	if e != nil {
+		if t, e := time.Parse(time.DateOnly, value); e == nil {
+			return t, nil
+		}
+	}
+
+	return t, e
+}
+
+// files assigns multipart file header(s) to the exported field(s) of type *[multipart.FileHeader] or
+// []*[multipart.FileHeader] of the struct pointed to by data. Field(s) are named, and nested struct(s) traversed, as
+// with [populate]'s "form" binding.
+func files(data interface{}, form *multipart.Form, known map[string]bool) {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+
+	v = v.Elem()
+
+	attach(v, form, known, map[reflect.Type]bool{v.Type(): true})
+}
+
+// attach assigns the form's file header(s) to v's field(s), reporting whether any were assigned. See [files].
+func attach(v reflect.Value, form *multipart.Form, known map[string]bool, ancestors map[reflect.Type]bool) bool {
+	var (
+		single   = reflect.TypeOf((*multipart.FileHeader)(nil))
+		multiple = reflect.TypeOf([]*multipart.FileHeader(nil))
+	)
+
+	var assigned bool
+
+	for index := 0; index < v.NumField(); index++ {
+		field := v.Type().Field(index)
+		if !(field.IsExported()) {
+			continue
+		}
+
+		name, tagged := key(field, "form")
+		if name == "-" {
+			continue
+		}
+
+		if !(tagged) && nested(field.Type) {
+			ok, _ := descend(v.Field(index), ancestors, func(v reflect.Value) (bool, error) {
+				return attach(v, form, known, ancestors), nil
+			})
+
+			assigned = assigned || ok
+
+			continue
+		}
+
+		if field.Type != single && field.Type != multiple {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		known[name] = true
+
+		headers := form.File[name]
+		if len(headers) == 0 {
+			continue
+		}
+
+		if field.Type == single {
+			v.Field(index).Set(reflect.ValueOf(headers[0]))
+		} else {
+			v.Field(index).Set(reflect.ValueOf(headers))
+		}
+
+		assigned = true
+	}
+
+	return assigned
+}
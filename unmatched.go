@@ -0,0 +1,121 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/x-ethr/server/types"
+)
+
+// methods represents the order of common HTTP method(s) within a response's "Allow" header; other registered method(s)
+// follow in lexical order.
+var methods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+}
+
+// allowed returns the method(s) of all registered route(s) matching the request's path.
+func (m *Mux) allowed(r *http.Request) []string {
+	candidates := m.methods()
+
+	allowed := make([]string, 0, len(candidates)+1)
+	for _, method := range candidates {
+		probe := *r
+		probe.Method = method
+
+		if _, pattern := m.mux.Handler(&probe); pattern != "" {
+			allowed = append(allowed, method)
+		}
+	}
+
+	if len(allowed) > 0 && !(slices.Contains(allowed, http.MethodOptions)) {
+		allowed = append(allowed, http.MethodOptions)
+	}
+
+	return allowed
+}
+
+// methods returns the distinct method(s) of every registered route, including HEAD for GET route(s), ordered per [methods].
+func (m *Mux) methods() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	registered := make([]string, 0, len(methods))
+	for _, e := range m.routes {
+		method := e.route.Method
+		if method == "" || slices.Contains(registered, method) {
+			continue
+		}
+
+		registered = append(registered, method)
+		if method == http.MethodGet && !(slices.Contains(registered, http.MethodHead)) {
+			registered = append(registered, http.MethodHead)
+		}
+	}
+
+	rank := func(method string) int {
+		if index := slices.Index(methods, method); index >= 0 {
+			return index
+		}
+
+		return len(methods)
+	}
+
+	slices.SortFunc(registered, func(a, b string) int {
+		if v := rank(a) - rank(b); v != 0 {
+			return v
+		}
+
+		return strings.Compare(a, b)
+	})
+
+	return registered
+}
+
+// unmatched handles requests that don't match a registered route:
+//
+//   - If no route matches the request's path, [Options.NotFound] is called.
+//   - If the request's method is OPTIONS, a 204 response is returned with the "Allow" header.
+//   - Otherwise, [Options.MethodNotAllowed] is called with the "Allow" header established.
+func (m *Mux) unmatched(w http.ResponseWriter, r *http.Request) {
+	allowed := m.allowed(r)
+	if len(allowed) == 0 {
+		m.options.NotFound.ServeHTTP(w, r)
+		return
+	}
+
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	m.options.MethodNotAllowed.ServeHTTP(w, r)
+}
+
+// response returns the [Mux] default handler for the given status code.
+func (m *Mux) response(status int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.options.Problems {
+			problem := &types.Problem{Status: status, Instance: r.URL.Path}
+			problem.Response(w)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(status)
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"code": status, "message": http.StatusText(status)})
+
+		return
+	})
+}
@@ -0,0 +1,270 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Upgrade represents the zero-downtime binary upgrade configuration. See [Lifecycle.Upgrade].
+type Upgrade struct {
+	// Signals represents the os signal(s) that initiate an upgrade, e.g. SIGUSR2. Defaults to an empty slice (disabled).
+	Signals []os.Signal
+
+	// Executable represents the path to the new binary. Defaults to the current process's executable (see [os.Executable]).
+	Executable string
+
+	// Arguments represents the new binary's argument(s). Defaults to the current process's argument(s).
+	Arguments []string
+
+	// Timeout represents the period to wait for the new process to report readiness, prior to abandoning the upgrade.
+	// Defaults to 30 seconds.
+	Timeout time.Duration
+}
+
+// Environment variable(s) describing the listener(s) inherited from a parent process during an upgrade.
+const (
+	inheritance = "X_ETHR_SERVER_LISTENERS" // inheritance represents a JSON array of listener key(s), ordered by file descriptor.
+	readiness   = "X_ETHR_SERVER_READY"     // readiness represents the file descriptor used to report the child's readiness.
+)
+
+// inherited represents the listener(s) passed by a parent process, keyed by [Instance.key].
+var inherited = struct {
+	once sync.Once
+
+	mutex     sync.Mutex
+	listeners map[string]net.Listener
+	remaining int
+	ready     *os.File
+}{}
+
+// Inherited reports whether the process was started by an upgrade, and inherited its listener(s) from the parent process.
+func Inherited() bool {
+	inherit()
+
+	inherited.mutex.Lock()
+	defer inherited.mutex.Unlock()
+
+	return inherited.ready != nil || len(inherited.listeners) > 0
+}
+
+// inherit parses the inherited listener(s), once. Inherited listener file descriptors start at 3.
+func inherit() {
+	inherited.once.Do(func() {
+		inherited.listeners = make(map[string]net.Listener)
+
+		value := os.Getenv(inheritance)
+		if value == "" {
+			return
+		}
+
+		descriptor, e := strconv.Atoi(os.Getenv(readiness))
+		if e != nil {
+			slog.Error("Invalid Inherited Readiness Descriptor", slog.String("error", e.Error()))
+			return
+		}
+
+		os.Unsetenv(inheritance)
+		os.Unsetenv(readiness)
+
+		var keys []string
+		if e := json.Unmarshal([]byte(value), &keys); e != nil {
+			slog.Error("Invalid Inherited Listener(s)", slog.String("error", e.Error()))
+			return
+		}
+
+		const start = 3
+
+		for index, key := range keys {
+			file := os.NewFile(uintptr(start+index), key)
+
+			listener, e := net.FileListener(file)
+			file.Close()
+
+			if e != nil {
+				slog.Error("Unable to Inherit Listener", slog.String("listener", key), slog.String("error", e.Error()))
+				continue
+			}
+
+			inherited.listeners[key] = listener
+		}
+
+		inherited.remaining = len(inherited.listeners)
+		inherited.ready = os.NewFile(uintptr(descriptor), "readiness")
+	})
+}
+
+// adopt returns, and removes, the inherited listener matching key. Once every inherited listener has been adopted, the
+// parent process is notified of the child's readiness.
+func adopt(key string) net.Listener {
+	inherit()
+
+	inherited.mutex.Lock()
+	defer inherited.mutex.Unlock()
+
+	listener, ok := inherited.listeners[key]
+	if !(ok) {
+		return nil
+	}
+
+	delete(inherited.listeners, key)
+
+	if inherited.remaining--; inherited.remaining == 0 && inherited.ready != nil {
+		inherited.ready.Write([]byte{1})
+		inherited.ready.Close()
+		inherited.ready = nil
+	}
+
+	return listener
+}
+
+// Upgrade starts the new binary (see [Upgrade]), passing it every listening socket. Once the new process reports
+// readiness, Upgrade initiates the shutdown (including the [Shutdown.Drain] period) of the current process; otherwise,
+// the new process is killed and the current process continues serving.
+//
+//   - The new process reports readiness automatically once it's listening on every inherited socket. Listener(s) are
+//     matched by their [Settings.Listener] configuration, address, and name (see [Supervisor.Server] and [Settings.Name]).
+//   - A shutdown initiated while waiting for the new process's readiness abandons the upgrade.
+//   - Only a single upgrade may be in progress at a time.
+//   - Upgrades aren't supported on Windows.
+func (l *Lifecycle) Upgrade(ctx context.Context) error {
+	server, ok := l.server.(interface {
+		listeners() (map[string]net.Listener, error)
+	})
+	if !(ok) {
+		return errors.New("upgrade unsupported by server")
+	}
+
+	if !(l.upgrading.CompareAndSwap(false, true)) {
+		return errors.New("upgrade already in progress")
+	}
+
+	defer l.upgrading.Store(false)
+
+	listeners, e := server.listeners()
+	if e != nil {
+		return e
+	} else if len(listeners) == 0 {
+		return errors.New("no listener(s) to pass to the upgrade")
+	}
+
+	configuration := l.configuration.Upgrade
+
+	executable := configuration.Executable
+	if executable == "" {
+		var e error
+		if executable, e = os.Executable(); e != nil {
+			return fmt.Errorf("unable to determine executable: %w", e)
+		}
+	}
+
+	arguments := configuration.Arguments
+	if arguments == nil {
+		arguments = os.Args[1:]
+	}
+
+	keys := make([]string, 0, len(listeners))
+	files := make([]*os.File, 0, len(listeners)+1)
+	defer func() {
+		for index := range files {
+			files[index].Close()
+		}
+	}()
+
+	for key, listener := range listeners {
+		v, ok := listener.(interface{ File() (*os.File, error) })
+		if !(ok) {
+			return fmt.Errorf("listener (%s) doesn't support file descriptor handoff", key)
+		}
+
+		file, e := v.File()
+		if e != nil {
+			return fmt.Errorf("listener (%s): %w", key, e)
+		}
+
+		keys = append(keys, key)
+		files = append(files, file)
+	}
+
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		return e
+	}
+
+	defer reader.Close()
+
+	files = append(files, writer)
+
+	value, _ := json.Marshal(keys)
+
+	command := exec.Command(executable, arguments...)
+	command.Stdin, command.Stdout, command.Stderr = os.Stdin, os.Stdout, os.Stderr
+	command.Env = append(os.Environ(), fmt.Sprintf("%s=%s", inheritance, value), fmt.Sprintf("%s=%d", readiness, 3+len(keys)))
+	command.ExtraFiles = files
+
+	slog.InfoContext(ctx, "Upgrading Server", slog.String("executable", executable), slog.Any("listeners", keys))
+
+	if e := command.Start(); e != nil {
+		return fmt.Errorf("unable to start upgrade: %w", e)
+	}
+
+	writer.Close() // only the child retains the write end; a child exit closes the pipe
+
+	ready := make(chan error, 1)
+	go func() {
+		buffer := make([]byte, 1)
+		if _, e := reader.Read(buffer); e != nil {
+			ready <- fmt.Errorf("upgrade exited prior to readiness: %w", e)
+			return
+		}
+
+		ready <- nil
+	}()
+
+	timeout := configuration.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case e = <-ready:
+	case <-timer.C:
+		e = errors.New("upgrade readiness timeout")
+	case <-l.stopping:
+		e = errors.New("shutdown initiated during upgrade")
+	}
+
+	if e != nil {
+		command.Process.Kill()
+		go command.Wait()
+
+		slog.ErrorContext(ctx, "Exception During Upgrade", slog.String("error", e.Error()))
+
+		return e
+	}
+
+	slog.InfoContext(ctx, "Upgrade Ready - Initializing Shutdown", slog.Int("pid", command.Process.Pid))
+
+	for _, listener := range listeners {
+		if v, ok := listener.(*net.UnixListener); ok {
+			v.SetUnlinkOnClose(false) // the socket file now belongs to the new process
+		}
+	}
+
+	command.Process.Release()
+
+	l.Stop()
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/x-ethr/server"
+)
+
+func TestUpgrade(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Upgrade(s) Unsupported on Windows")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	local := func(s *server.Settings) {
+		s.Host = "127.0.0.1"
+	}
+
+	// The upgraded (child) process serves a single request, then shuts down.
+	if server.Inherited() {
+		var lifecycle *server.Lifecycle
+
+		api := server.Server(ctx, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("child"))
+
+			go lifecycle.Stop()
+		}), "0", local)
+
+		lifecycle = server.Interrupt(ctx, cancel, api, func(s *server.Shutdown) {
+			s.Signals = nil
+		})
+
+		time.AfterFunc(10*time.Second, lifecycle.Stop)
+
+		api.ListenAndServe()
+
+		lifecycle.Wait()
+
+		return
+	}
+
+	api := server.Server(ctx, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("parent"))
+	}), "0", local)
+
+	lifecycle := server.Interrupt(ctx, cancel, api, func(s *server.Shutdown) {
+		s.Signals = nil
+		s.Upgrade.Arguments = []string{"-test.run=^TestUpgrade$"}
+		s.Upgrade.Timeout = 10 * time.Second
+	})
+
+	go api.ListenAndServe()
+
+	<-api.Listening()
+
+	address := "http://" + api.Addr().String()
+
+	if e := lifecycle.Upgrade(ctx); e != nil {
+		t.Fatalf("Unexpected Upgrade Error: %v", e)
+	}
+
+	if e := lifecycle.Wait(); e != nil {
+		t.Fatalf("Unexpected Shutdown Error: %v", e)
+	}
+
+	response, e := http.Get(address)
+	if e != nil {
+		t.Fatalf("Expected Upgraded Process to Serve: %v", e)
+	}
+
+	defer response.Body.Close()
+
+	body, _ := io.ReadAll(response.Body)
+	if string(body) != "child" {
+		t.Errorf("Expected Upgraded Process Response, Received: %s", body)
+	}
+}
+
+func TestUpgradeListeners(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Upgrade(s) Unsupported on Windows")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, test := range []struct {
+		name  string
+		names []string
+		error string
+	}{
+		{name: "Distinct", names: []string{"public", "admin"}, error: "unable to start upgrade"},
+		{name: "Duplicate", names: []string{"public", "public"}, error: "duplicate listener"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			supervisor := server.Supervise(ctx)
+
+			for _, name := range test.names {
+				instance := server.Server(ctx, http.NotFoundHandler(), "0", func(s *server.Settings) { s.Host = "127.0.0.1" })
+				supervisor.Server(name, instance)
+
+				listener, e := instance.Listen()
+				if e != nil {
+					t.Fatalf("Unexpected Error: %v", e)
+				}
+
+				defer listener.Close()
+			}
+
+			lifecycle := server.Interrupt(ctx, func() {}, supervisor, func(s *server.Shutdown) {
+				s.Signals, s.Reloads = nil, nil
+				s.Upgrade.Executable = filepath.Join(t.TempDir(), "missing")
+			})
+
+			defer lifecycle.Stop()
+
+			if e := lifecycle.Upgrade(ctx); e == nil || !(strings.Contains(e.Error(), test.error)) {
+				t.Errorf("Expected Upgrade Error (%s), Received: %v", test.error, e)
+			}
+		})
+	}
+}